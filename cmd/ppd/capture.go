@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stratosnet/sds/cmd/common"
+	"github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/capture"
+	"github.com/stratosnet/sds/pp/event"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/network"
+	"github.com/stratosnet/sds/pp/p2pserver"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/pp/types"
+)
+
+const (
+	typeFlag  = "type"
+	peerFlag  = "peer"
+	indexFlag = "index"
+	waitFlag  = "wait"
+)
+
+func getCaptureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capture",
+		Short: "read or replay the messages captured by the node (see the [capture] config)",
+	}
+
+	printCmd := &cobra.Command{
+		Use:   "print <capture file>",
+		Short: "print the captured messages",
+		Args:  cobra.ExactArgs(1),
+		RunE:  printCapture,
+	}
+
+	replayCmd := &cobra.Command{
+		Use:   "replay <capture file>",
+		Short: "pass the captured inbound messages to the event handlers again, without connecting to the network",
+		Long: "pass the captured inbound messages to the event handlers again, without connecting to the network. " +
+			"The messages written by the handlers are printed instead of being sent. The handlers can change the " +
+			"files of the node, so replay against a copy of the node home",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: common.NodePreRunE,
+		RunE:    replayCapture,
+	}
+	replayCmd.Flags().IntSlice(indexFlag, nil, "indexes of the messages to replay, as printed by \"capture print\"")
+	replayCmd.Flags().Duration(waitFlag, 5*time.Second, "time left to the handlers to finish after the last message")
+
+	cmd.PersistentFlags().StringSlice(typeFlag, nil, "only the messages of these types (eg: ReqUpLFS)")
+	cmd.PersistentFlags().String(peerFlag, "", "only the messages exchanged with this P2P address")
+	cmd.AddCommand(printCmd)
+	cmd.AddCommand(replayCmd)
+	return cmd
+}
+
+// captureFilter selects the records matching the type and peer flags
+func captureFilter(cmd *cobra.Command) (func(record *capture.Record) bool, error) {
+	msgTypes, err := cmd.Flags().GetStringSlice(typeFlag)
+	if err != nil {
+		return nil, err
+	}
+	peer, err := cmd.Flags().GetString(peerFlag)
+	if err != nil {
+		return nil, err
+	}
+	return func(record *capture.Record) bool {
+		if peer != "" && record.Peer != peer {
+			return false
+		}
+		if len(msgTypes) == 0 {
+			return true
+		}
+		for _, msgType := range msgTypes {
+			if record.Type == msgType {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func printCapture(cmd *cobra.Command, args []string) error {
+	filter, err := captureFilter(cmd)
+	if err != nil {
+		return err
+	}
+	return capture.ReadFile(args[0], func(index int, record *capture.Record) error {
+		if filter(record) {
+			printRecord(index, record)
+		}
+		return nil
+	})
+}
+
+func printRecord(index int, record *capture.Record) {
+	direction := "sent to"
+	if record.Inbound {
+		direction = "received from"
+	}
+	fmt.Printf("#%v %v %v %v %v req_id=%v packet_id=%v body=%vB data=%vB\n", index,
+		record.Time.Format("2006-01-02 15:04:05.000"), record.Type, direction, record.Peer, record.ReqId,
+		record.PacketId, len(record.Body), record.DataLen)
+	printMessage(record.Message)
+}
+
+func printMessage(message []byte) {
+	if len(message) == 0 {
+		return
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, message, "    ", "  "); err != nil {
+		return
+	}
+	fmt.Println("    " + indented.String())
+}
+
+func replayCapture(cmd *cobra.Command, args []string) error {
+	filter, err := captureFilter(cmd)
+	if err != nil {
+		return err
+	}
+	indexes, err := cmd.Flags().GetIntSlice(indexFlag)
+	if err != nil {
+		return err
+	}
+	wait, err := cmd.Flags().GetDuration(waitFlag)
+	if err != nil {
+		return err
+	}
+	selected := make(map[int]bool)
+	for _, index := range indexes {
+		selected[index] = true
+	}
+
+	ctx, err := replayContext()
+	if err != nil {
+		return err
+	}
+	conn := &capture.ReplayConn{OnWrite: func(m *msg.RelayMsgBuf) {
+		msgType := "unknown"
+		if t := header.GetMsgTypeFromId(m.MSGHead.Cmd); t != nil {
+			msgType = t.Name
+		}
+		fmt.Printf("  -> the handler answered %v req_id=%v\n", msgType, m.MSGHead.ReqId)
+		message, _ := capture.DecodeBody(m.MSGHead.Cmd, m.MSGBody)
+		printMessage(message)
+	}}
+
+	err = capture.ReadFile(args[0], func(index int, record *capture.Record) error {
+		if !record.Inbound || !filter(record) || (len(selected) > 0 && !selected[index]) {
+			return nil
+		}
+		printRecord(index, record)
+		if err := capture.Replay(ctx, record, conn); err != nil {
+			fmt.Println("  failed replaying the message:", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	time.Sleep(wait)
+	_ = file.CloseSliceStore()
+	return nil
+}
+
+// replayContext the context of the handlers, like when the node is started, but without connecting to the network
+func replayContext() (context.Context, error) {
+	p2pServ := &p2pserver.P2pServer{}
+	if err := p2pServ.Init(); err != nil {
+		return nil, err
+	}
+	if err := utils.InitIdWorker(p2pServ.GetP2PAddress().Bytes()[0]); err != nil {
+		return nil, err
+	}
+	utils.InitBufferPool(setting.MaxData, setting.GetDataBufferSize())
+	if err := file.InitSliceStore(); err != nil {
+		return nil, err
+	}
+	event.RegisterAllEventHandlers()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, p2pServ)
+	ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, &network.Network{})
+	return ctx, nil
+}
@@ -18,6 +18,7 @@ func main() {
 	verCmd := getVersionCmd()
 	exportCmd := getExportCmd()
 	cleanCmd := getCleanCmd()
+	captureCmd := getCaptureCmd()
 
 	rootCmd.AddCommand(nodeCmd)
 	rootCmd.AddCommand(terminalCmd)
@@ -25,6 +26,7 @@ func main() {
 	rootCmd.AddCommand(verCmd)
 	rootCmd.AddCommand(exportCmd)
 	rootCmd.AddCommand(cleanCmd)
+	rootCmd.AddCommand(captureCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
@@ -46,7 +46,10 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 		"prepay <amount> <fee> [--beneficiary=<beneficiary>] [--gas=<gas>]\n" +
 		"                                                               prepay stos to get ozone\n" +
 		"put <filepath> [--isEncrypted=<isEncrypted>] [--nodeTier=<nodeTier>] [--allowHigherTier=<allowHigherTier>]\n" +
-		"                                                               upload file, need to consume ozone\n" +
+		"    [--recursive=<recursive>] [--concurrency=<concurrency>] [--encryptionKey=<hex key>|random]\n" +
+		"                                                               upload file, need to consume ozone. with --recursive=true, upload all the files\n" +
+		"                                                               of a directory and a manifest of the directory. with --encryptionKey, the file is\n" +
+		"                                                               encrypted with this content key, so it can be shared encrypted\n" +
 		"putstream <filepath> [--nodeTier=<nodeTier>] [--allowHigherTier=<allowHigherTier>]\n" +
 		"                                                               upload video file for streaming, need to consume ozone. (alpha version, encode format config impossible)\n" +
 		"list <filename>                                                query uploaded file by self\n" +
@@ -54,10 +57,14 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 		"delete <filehash>                                              delete file\n" +
 		"get <sdm://account/filehash> <saveAs>                          download file, need to consume ozone\n" +
 		"                                                               e.g: get sdm://st1jn9skjsnxv26mekd8eu8a8aquh34v0m4mwgahg/v05ahm50ugfjrgd3ga8mqi6bqka32ks3dooe1p9g\n" +
-		"sharefile <filehash> <duration> <is_private> [--ipfsCid=<cid>]\n" +
-		"                                                               share an uploaded file\n" +
+		"get <sdm://account/filehash> --recursive=true [--concurrency=<concurrency>]\n" +
+		"                                                               restore a directory from its manifest into the download folder\n" +
+		"sharefile <filehash> <duration> <is_private> [--ipfsCid=<cid>] [--recipient=<wallet>[:<encryption pubkey>]]...\n" +
+		"                                                               share an uploaded file. with --recipient, the share link can only be used by the given\n" +
+		"                                                               wallets, and the content key of an encrypted file is added to it for each of them\n" +
 		"allshare                                                       list all shared files\n" +
-		"getsharefile sds://<sharelink>/<password>                      download a shared file, need to consume ozone\n" +
+		"getsharefile sds://<sharelink>/<password>[/<recipients>]       download a shared file, need to consume ozone\n" +
+		"encryptionpubkey                                               show the wallet and the public key receiving the content keys of files shared with it\n" +
 		"cancelshare <shareID>                                          cancel a shared file\n" +
 		"clearexpshare                                                  clear all expired share links\n" +
 		"ver                                                            version\n" +
@@ -71,6 +78,11 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 		"backupstatus <filehash>                                        get backup status of an file\n" +
 		"maintenance start <duration>                                   put the node in maintenance mode for the requested duration (in seconds)\n" +
 		"maintenance stop                                               stop the current maintenance, restart pp is required after this command is executed\n" +
+		"putversion <key> <filepath>                                    upload a file as the new latest version of the object key\n" +
+		"versions <key>                                                 list the versions of the object key\n" +
+		"getversion <key> [version]                                     download a version of the object key, the latest one by default\n" +
+		"rollback <key> <version>                                       make a previous version of the object key the latest one again\n" +
+		"scrub [start|stop|status]                                      verify the integrity of the stored slices, or show the result of the last verification\n" +
 		"downgradeinfo                                                  get information of last downgrade happened on this pp node\n" +
 		"replicas                                                       check or set the expect replicas of a file\n" +
 		"performancemeasure                                             turn on performance measurement log for 60 seconds\n" +
@@ -205,6 +217,10 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 		return callRpc(c, terminalId, "getShareFile", param)
 	}
 
+	encryptionpubkey := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "encryptionPubkey", param)
+	}
+
 	pauseget := func(line string, param []string) bool {
 		return callRpc(c, terminalId, "pauseGet", param)
 	}
@@ -222,6 +238,21 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 	maintenance := func(line string, param []string) bool {
 		return callRpc(c, terminalId, "maintenance", param)
 	}
+	putVersion := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "putVersion", param)
+	}
+	versions := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "versions", param)
+	}
+	getVersion := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "getVersion", param)
+	}
+	rollback := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "rollback", param)
+	}
+	scrub := func(line string, param []string) bool {
+		return callRpc(c, terminalId, "scrub", param)
+	}
 	downgradeInfo := func(line string, param []string) bool {
 		return callRpc(c, terminalId, "downgradeInfo", param)
 	}
@@ -286,6 +317,7 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 	console.Mystdin.RegisterProcessFunc("allshare", allshare, false)
 	console.Mystdin.RegisterProcessFunc("cancelshare", cancelshare, true)
 	console.Mystdin.RegisterProcessFunc("getsharefile", getsharefile, true)
+	console.Mystdin.RegisterProcessFunc("encryptionpubkey", encryptionpubkey, false)
 	console.Mystdin.RegisterProcessFunc("clearexpshare", clearexpshare, true)
 
 	console.Mystdin.RegisterProcessFunc("pauseget", pauseget, true)
@@ -293,6 +325,11 @@ func run(cmd *cobra.Command, args []string, isExec bool) {
 	console.Mystdin.RegisterProcessFunc("cancelget", cancelget, true)
 	console.Mystdin.RegisterProcessFunc("monitortoken", monitortoken, true)
 	console.Mystdin.RegisterProcessFunc("maintenance", maintenance, true)
+	console.Mystdin.RegisterProcessFunc("putversion", putVersion, true)
+	console.Mystdin.RegisterProcessFunc("versions", versions, true)
+	console.Mystdin.RegisterProcessFunc("getversion", getVersion, true)
+	console.Mystdin.RegisterProcessFunc("rollback", rollback, true)
+	console.Mystdin.RegisterProcessFunc("scrub", scrub, true)
 	console.Mystdin.RegisterProcessFunc("downgradeinfo", downgradeInfo, true)
 	console.Mystdin.RegisterProcessFunc("performancemeasure", performanceMeasure, true)
 	console.Mystdin.RegisterProcessFunc("replicas", replica, true)
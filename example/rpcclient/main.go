@@ -184,6 +184,7 @@ func main() {
 		Short: "share a file from uploaded files",
 		RunE:  share,
 	}
+	shareCmd.Flags().StringSlice("recipient", nil, "wallet address the share link is bound to, can be repeated")
 
 	listsharedCmd := &cobra.Command{
 		Use:   "listshared",
@@ -1169,11 +1169,15 @@ func listshare(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func reqShareMsg(hash string) []byte {
+func reqShareMsg(hash string, recipients []string) []byte {
 	// param
 	nowSec := time.Now().Unix()
 	// signature
-	sign, err := WalletPrivateKey.Sign([]byte(msgutils.GetShareFileWalletSignMessage(hash, WalletAddress, nowSec)))
+	signMsg := msgutils.GetShareFileWalletSignMessage(hash, WalletAddress, nowSec)
+	if len(recipients) > 0 {
+		signMsg = msgutils.ShareFileToRecipientsWalletSignMessage(hash, WalletAddress, recipients, nowSec)
+	}
+	sign, err := WalletPrivateKey.Sign([]byte(signMsg))
 	if err != nil {
 		return nil
 	}
@@ -1192,6 +1196,9 @@ func reqShareMsg(hash string) []byte {
 		},
 		ReqTime: nowSec,
 	})
+	for _, recipient := range recipients {
+		params[0].Recipients = append(params[0].Recipients, rpc.ShareRecipient{WalletAddress: recipient})
+	}
 
 	pm, e := json.Marshal(params)
 	if e != nil {
@@ -1209,8 +1216,12 @@ func share(cmd *cobra.Command, args []string) error {
 		utils.ErrorLog("file hash is not provided")
 		return nil
 	}
+	recipients, err := cmd.Flags().GetStringSlice("recipient")
+	if err != nil {
+		return err
+	}
 	// compose request
-	r := reqShareMsg(args[0])
+	r := reqShareMsg(args[0], recipients)
 	if r == nil {
 		return nil
 	}
@@ -1223,7 +1234,7 @@ func share(cmd *cobra.Command, args []string) error {
 	}
 	// handle response
 	var rsp jsonrpcMessage
-	err := json.Unmarshal(body, &rsp)
+	err = json.Unmarshal(body, &rsp)
 	if err != nil {
 		return err
 	}
@@ -1339,8 +1350,9 @@ func reqGetSharedMsg(shareLink, sn string) []byte {
 			Pubkey:    wpk,
 			Signature: hex.EncodeToString(sign),
 		},
-		ReqTime:   nowSec,
-		ShareLink: shareLink,
+		ReqTime:        nowSec,
+		ShareLink:      shareLink,
+		SequenceNumber: sn,
 	})
 
 	pm, e := json.Marshal(params)
@@ -10,6 +10,7 @@ import (
 	"net"
 	"strconv"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/alex023/clock"
@@ -18,6 +19,7 @@ import (
 	"github.com/stratosnet/sds/framework/core"
 	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
 	"github.com/stratosnet/sds/framework/crypto/encryption"
+	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
 	"github.com/stratosnet/sds/framework/metrics"
 	"github.com/stratosnet/sds/framework/msg"
 	"github.com/stratosnet/sds/framework/msg/header"
@@ -25,11 +27,6 @@ import (
 	"github.com/stratosnet/sds/framework/utils"
 )
 
-var (
-	maxDownloadRate uint64
-	maxUploadRate   uint64
-)
-
 const (
 	LOG_MODULE_START      = "start: "
 	LOG_MODULE_WRITELOOP  = "writeLoop: "
@@ -55,22 +52,34 @@ type ContextKV struct {
 	Value interface{}
 }
 type options struct {
-	onConnect   onConnectFunc
-	onWrite     onWriteFunc
-	onRead      onReadFunc
-	onHandle    onHandleFunc
-	onClose     onCloseFunc
-	onError     onErrorFunc
-	bufferSize  int
-	reconnect   bool // only ClientConn
-	heartClose  bool
-	logOpen     bool
-	minAppVer   uint16
-	p2pAddress  string
-	serverIp    net.IP
-	serverPort  uint16
-	contextkv   []ContextKV
-	readTimeout int64
+	onConnect  onConnectFunc
+	onWrite    onWriteFunc
+	onRead     onReadFunc
+	onHandle   onHandleFunc
+	onClose    onCloseFunc
+	onError    onErrorFunc
+	bufferSize int
+	reconnect  bool // only ClientConn
+	heartClose bool
+	logOpen    bool
+	minAppVer  uint16
+	p2pAddress string
+	p2pKey     fwcryptotypes.PrivKey
+	// remoteP2pAddress the P2P address the server should prove during the handshake, not checked when empty
+	remoteP2pAddress string
+	// minHandshakeVersion servers only supporting a lower handshake version are rejected
+	minHandshakeVersion uint8
+	// peerHandshakeVersion the handshake version advertised by the server, the handshake isn't downgraded below it
+	peerHandshakeVersion uint8
+	serverIp             net.IP
+	serverPort           uint16
+	contextkv            []ContextKV
+	readTimeout          int64
+	// dial opens the conn with another transport than TCP. TCP is the fallback when it fails
+	dial func() (net.Conn, error)
+	// compressionThreshold the messages of at least this size are compressed, when the server accepts it. 0 disables it
+	compressionThreshold int
+	onCapture            core.CaptureFunc
 }
 
 // ClientOption client configuration
@@ -115,6 +124,8 @@ type ClientConn struct {
 	remoteP2pAddress string
 	writeHook        []WriteHook
 	encryptMessage   bool
+	// compressionThreshold the messages of at least this size are compressed, 0 when the peers didn't agree on it
+	compressionThreshold int
 }
 
 func ReconnectOption(rec bool) ClientOption {
@@ -162,6 +173,55 @@ func P2pAddressOption(p2pAddress string) ClientOption {
 	}
 }
 
+// RemoteP2pAddressOption sets the P2P address of the server. The conn is refused when the server proves another one
+// during the handshake, e.g. when the route to the server is wrong
+func RemoteP2pAddressOption(p2pAddress string) ClientOption {
+	return func(o *options) {
+		o.remoteP2pAddress = p2pAddress
+	}
+}
+
+// P2pKeyOption sets the P2P key proving the local P2P address during the handshake
+func P2pKeyOption(p2pKey fwcryptotypes.PrivKey) ClientOption {
+	return func(o *options) {
+		o.p2pKey = p2pKey
+	}
+}
+
+func MinHandshakeVersionOption(version uint8) ClientOption {
+	return func(o *options) {
+		o.minHandshakeVersion = version
+	}
+}
+
+// PeerHandshakeVersionOption sets the handshake version advertised by the server in its node info, 0 when unknown
+func PeerHandshakeVersionOption(version uint8) ClientOption {
+	return func(o *options) {
+		o.peerHandshakeVersion = version
+	}
+}
+
+// DialOption sets the function opening the conn with another transport, like a QUIC stream
+func DialOption(dial func() (net.Conn, error)) ClientOption {
+	return func(o *options) {
+		o.dial = dial
+	}
+}
+
+// CaptureOption passes each message written or read by the conn to cb, once it is sent or received entirely
+func CaptureOption(cb core.CaptureFunc) ClientOption {
+	return func(o *options) {
+		o.onCapture = cb
+	}
+}
+
+// CompressionOption compresses the messages of at least threshold bytes, when the server accepts it
+func CompressionOption(threshold int) ClientOption {
+	return func(o *options) {
+		o.compressionThreshold = threshold
+	}
+}
+
 // ServerIpOption sets the IP used by the server conn when establishing the handshake
 func ServerIpOption(serverIp net.IP) ClientOption {
 	return func(o *options) {
@@ -242,14 +302,6 @@ func (cc *ClientConn) GetName() string {
 	return name
 }
 
-func SetMaxDownloadRate(rate uint64) {
-	maxDownloadRate = rate
-}
-
-func SetMaxUploadRate(rate uint64) {
-	maxUploadRate = rate
-}
-
 // GetIP get connection ip
 func (cc *ClientConn) GetIP() string {
 	cc.mu.Lock()
@@ -268,7 +320,7 @@ func (cc *ClientConn) GetPort() string {
 func (cc *ClientConn) GetLocalAddr() string {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
-	if cc.spbConn == nil || cc.spbConn.(*net.TCPConn) == nil || cc.spbConn.LocalAddr() == nil {
+	if cc.spbConn == nil || cc.spbConn.LocalAddr() == nil {
 		return ""
 	}
 	return cc.spbConn.LocalAddr().String()
@@ -277,7 +329,7 @@ func (cc *ClientConn) GetLocalAddr() string {
 func (cc *ClientConn) GetRemoteAddr() string {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
-	if cc.spbConn == nil || cc.spbConn.(*net.TCPConn) == nil || cc.spbConn.RemoteAddr() == nil {
+	if cc.spbConn == nil || cc.spbConn.RemoteAddr() == nil {
 		return ""
 	}
 	return cc.spbConn.RemoteAddr().String()
@@ -309,7 +361,9 @@ func (cc *ClientConn) SetWriteHook(h []WriteHook) {
 	cc.mu.Unlock()
 }
 
-func (cc *ClientConn) handshake() error {
+// handshake establishes the shared key of the conn, and the P2P address of the server. The client proposes the
+// highest handshake version it supports, and the server answers with the version to use
+func (cc *ClientConn) handshake(maxVersion uint8) error {
 	// Set handshake timeout
 	if err := cc.spbConn.SetDeadline(time.Now().Add(time.Duration(utils.HandshakeTimeOut) * time.Second)); err != nil {
 		return err
@@ -325,10 +379,28 @@ func (cc *ClientConn) handshake() error {
 	}()
 
 	// Write the connection type as first message
-	firstMessage := core.CreateFirstMessage(core.ConnTypeClient, cc.opts.serverIp, cc.opts.serverPort, channelId)
+	firstMessage := core.CreateFirstMessage(core.ClientConnType(maxVersion), cc.opts.serverIp, cc.opts.serverPort, channelId)
 	if err := core.WriteFull(cc.spbConn, firstMessage); err != nil {
 		return err
 	}
+	version := core.HandshakeVersion1
+	if maxVersion >= core.HandshakeVersion2 {
+		// a legacy server closes the conn, since it doesn't know the conn type
+		buffer := make([]byte, core.HandshakeVersionSize)
+		if _, err := io.ReadFull(cc.spbConn, buffer); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF || errors.Is(err, syscall.ECONNRESET) {
+				return core.ErrLegacyHandshake
+			}
+			return err
+		}
+		version = buffer[0]
+		if version < core.HandshakeVersion1 || version > maxVersion {
+			return errors.Errorf("invalid handshake version %v from server", version)
+		}
+	}
+	if version < cc.opts.minHandshakeVersion {
+		return errors.Errorf("handshake version %v is below the minimum version %v", version, cc.opts.minHandshakeVersion)
+	}
 
 	// Create tmp key
 	tmpPrivKey := fwed25519.GenPrivKey()
@@ -344,15 +416,22 @@ func (cc *ClientConn) handshake() error {
 		return err
 	}
 
-	// Receive tmp key from channel:
+	// Receive tmp key from the conn from version 3, or from channel:
 	var tmpKeyMsg []byte
-	select {
-	case tmpKeyMsg = <-handshakeChan:
-		if len(tmpKeyMsg) < fwed25519.PubKeySize+fwed25519.SignatureSize {
-			return errors.Errorf("Handshake message too small (%v bytes)", len(tmpKeyMsg))
+	if version >= core.HandshakeVersion3 {
+		tmpKeyMsg = make([]byte, fwed25519.PubKeySize+fwed25519.SignatureSize)
+		if _, err = io.ReadFull(cc.spbConn, tmpKeyMsg); err != nil {
+			return err
+		}
+	} else {
+		select {
+		case tmpKeyMsg = <-handshakeChan:
+			if len(tmpKeyMsg) < fwed25519.PubKeySize+fwed25519.SignatureSize {
+				return errors.Errorf("Handshake message too small (%v bytes)", len(tmpKeyMsg))
+			}
+		case <-time.After(utils.HandshakeTimeOut * time.Second):
+			return errors.New("Timed out when reading from server channel")
 		}
-	case <-time.After(utils.HandshakeTimeOut * time.Second):
-		return errors.New("Timed out when reading from server channel")
 	}
 
 	peerPubKeyBytes := tmpKeyMsg[:fwed25519.PubKeySize]
@@ -370,8 +449,15 @@ func (cc *ClientConn) handshake() error {
 	}
 	cc.sharedKey = sharedPrivKeyBytes
 
-	// Send local p2p address
-	encryptedMsg, err := core.Pack(sharedPrivKeyBytes, []byte(cc.GetLocalP2pAddress()))
+	// Send local p2p address, signed with the p2p key from version 2
+	localIdentity := []byte(cc.GetLocalP2pAddress())
+	if version >= core.HandshakeVersion2 {
+		localIdentity, err = core.CreateHandshakeIdentity(cc.opts.p2pKey, cc.GetLocalP2pAddress(), true, tmpPubKeyBytes, peerPubKeyBytes)
+		if err != nil {
+			return err
+		}
+	}
+	encryptedMsg, err := core.Pack(sharedPrivKeyBytes, localIdentity)
 	if err != nil {
 		return err
 	}
@@ -380,29 +466,68 @@ func (cc *ClientConn) handshake() error {
 	}
 
 	// Read remote p2p address
-	p2pAddressBytes, _, err := core.Unpack(cc.spbConn, sharedPrivKeyBytes, utils.MessageBeatLen)
+	remoteIdentity, _, err := core.Unpack(cc.spbConn, sharedPrivKeyBytes, utils.MessageBeatLen)
 	if err != nil {
 		return err
 	}
-	cc.remoteP2pAddress = string(p2pAddressBytes)
-	if _, err = fwtypes.P2PAddressFromBech32(cc.remoteP2pAddress); err != nil {
-		return errors.Wrap(err, "incorrect P2pAddress")
+	if version >= core.HandshakeVersion2 {
+		if cc.remoteP2pAddress, err = core.VerifyHandshakeIdentity(remoteIdentity, false, tmpPubKeyBytes, peerPubKeyBytes); err != nil {
+			return err
+		}
+	} else {
+		cc.remoteP2pAddress = string(remoteIdentity)
+		if _, err = fwtypes.P2PAddressFromBech32(cc.remoteP2pAddress); err != nil {
+			return errors.Wrap(err, "incorrect P2pAddress")
+		}
+	}
+	if cc.opts.remoteP2pAddress != "" && cc.remoteP2pAddress != cc.opts.remoteP2pAddress {
+		return errors.Errorf("the server is %v instead of %v", cc.remoteP2pAddress, cc.opts.remoteP2pAddress)
+	}
+	if err = core.CheckMuxStreamP2pAddress(cc.spbConn, cc.remoteP2pAddress); err != nil {
+		return err
+	}
+
+	// Exchange the accepted compression from version 4
+	cc.compressionThreshold = 0
+	if version >= core.HandshakeVersion4 {
+		compression := uint8(0)
+		if cc.opts.compressionThreshold > 0 {
+			compression = core.CompressionZstd
+		}
+		if compression, err = core.ExchangeCompression(cc.spbConn, sharedPrivKeyBytes, compression); err != nil {
+			return err
+		}
+		if compression&core.CompressionZstd != 0 {
+			cc.compressionThreshold = cc.opts.compressionThreshold
+		}
 	}
 
 	return cc.spbConn.SetDeadline(time.Time{}) // Remove handshake timeout
 }
 
+// dial opens the conn with the transport of the dial option, or with TCP
+func (cc *ClientConn) dial(tcpAddr *net.TCPAddr) (net.Conn, error) {
+	if cc.opts.dial != nil {
+		conn, err := cc.opts.dial()
+		if err == nil {
+			return conn, nil
+		}
+		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("failed to dial %v, falling back to tcp: %v", cc.addr, err.Error()))
+	}
+	return net.DialTCP("tcp", nil, tcpAddr)
+}
+
 // Start client starts readLoop, writeLoop, handleLoop
 func (cc *ClientConn) Start() {
 	cc.encryptMessage = true
 
-	tcpAddr, err := net.ResolveTCPAddr("tcp4", cc.addr)
+	tcpAddr, err := net.ResolveTCPAddr("tcp", cc.addr)
 	if err != nil {
 		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("bad server address: %v, %v", cc.addr, err.Error()))
 		cc.ClientClose(false)
 		return
 	}
-	cc.spbConn, err = net.DialTCP("tcp", nil, tcpAddr)
+	cc.spbConn, err = cc.dial(tcpAddr)
 	if err != nil {
 		utils.DebugLogf("cc.spbConn:%p", cc.spbConn)
 		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("failed to dial tcp: %v, %v", tcpAddr.String(), err.Error()))
@@ -411,7 +536,23 @@ func (cc *ClientConn) Start() {
 	}
 	metrics.ConnNumbers.WithLabelValues("client").Inc()
 
-	err = cc.handshake()
+	maxVersion := core.HandshakeVersion1
+	if cc.opts.p2pKey != nil {
+		maxVersion = core.LatestHandshakeVersion
+	}
+	err = cc.handshake(maxVersion)
+	for err == core.ErrLegacyHandshake {
+		// the server closed the conn without knowing the proposed version, try again with the previous one
+		var downgraded bool
+		maxVersion, downgraded = core.DowngradeHandshakeVersion(maxVersion, cc.opts.minHandshakeVersion, cc.opts.peerHandshakeVersion)
+		if !downgraded {
+			break
+		}
+		_ = cc.spbConn.Close()
+		if cc.spbConn, err = cc.dial(tcpAddr); err == nil {
+			err = cc.handshake(maxVersion)
+		}
+	}
 	if err != nil {
 		Mylog(cc.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("handshake error %v -> %v, %v", cc.spbConn.LocalAddr(), cc.spbConn.RemoteAddr(), err.Error()))
 		cc.ClientClose(true)
@@ -610,6 +751,7 @@ func asyncWrite(c *ClientConn, m *msg.RelayMsgBuf, ctx context.Context) (err err
 	}
 
 	m.PacketId = core.GetPacketIdFromContext(ctx)
+	core.TraceWrite(ctx, m, c.remoteP2pAddress)
 
 	sendCh <- m
 	if c.opts.onWrite != nil {
@@ -644,8 +786,8 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 
 	var msgH header.MessageHead
 	var msgS msg.MessageSign
-	var lr utils.LimitRate
 	var headerBytes []byte
+	var traceContext []byte
 	var n int
 	var err error
 	var key []byte
@@ -682,6 +824,7 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 					return
 				}
 				copy(msgBuf[:header.MsgHeaderLen], headerBytes[:header.MsgHeaderLen])
+				traceContext = core.ReadTraceContext(headerBytes)
 				msgH.Decode(headerBytes[:header.MsgHeaderLen])
 				if msgH.Version < cc.opts.minAppVer {
 					msgType := header.GetMsgTypeFromId(msgH.Cmd)
@@ -697,7 +840,7 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 				listenHeader = false
 			} else {
 				// listen to the second part: body + sign + data. They are concatenated to the header in msgBuf.
-				nonce, secondPartLen, n, err := core.ReadEncryptionHeader(spbConn)
+				nonce, secondPartLen, compressed, n, err := core.ReadEncryptionHeader(spbConn)
 				cc.secondReadFlowA = cc.secondReadAtomA.AddAndGetNew(int64(n))
 				if err != nil {
 					Mylog(cc.opts.logOpen, LOG_MODULE_READLOOP, "read encrypted header err: "+err.Error())
@@ -729,12 +872,7 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 						Mylog(cc.opts.logOpen, LOG_MODULE_READLOOP, "read server body err: "+err.Error())
 						return
 					}
-					if cmd == header.RspDownloadSlice.Id {
-						if maxDownloadRate > 0 {
-							lr.SetRate(maxDownloadRate)
-							lr.Limit()
-						}
-					}
+					core.ShapeDownload(cc.remoteP2pAddress, cmd, n)
 				}
 
 				// handle the second part after all bytes are received
@@ -753,6 +891,14 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 					} else {
 						posEnd = posBody + secondPartLen
 					}
+					if compressed {
+						secondPart, err := core.Decompress(msgBuf[posBody:posEnd], len(msgBuf)-int(posBody))
+						if err != nil {
+							utils.ErrorLog("client body decompression err", err)
+							return
+						}
+						posEnd = posBody + uint32(copy(msgBuf[posBody:], secondPart))
+					}
 
 					// verify signature
 					msgS.Decode(msgBuf[posSign : posSign+msg.MsgSignLen])
@@ -762,8 +908,9 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 					}
 					// message body goes to field MSGBody, data goes to field MSGData if it exists
 					message = &msg.RelayMsgBuf{
-						MSGHead: header.CopyMessageHeader(msgH),
-						MSGBody: make([]byte, posSign-posBody),
+						MSGHead:      header.CopyMessageHeader(msgH),
+						MSGBody:      make([]byte, posSign-posBody),
+						TraceContext: traceContext,
 					}
 					copy(message.MSGBody[:], msgBuf[posBody:posSign])
 
@@ -774,6 +921,9 @@ func readLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 					if cc.opts.onRead != nil {
 						cc.opts.onRead(message)
 					}
+					if cc.opts.onCapture != nil {
+						cc.opts.onCapture(true, cc.remoteP2pAddress, message)
+					}
 
 					handler := core.GetHandlerFunc(cmd)
 					if handler == nil {
@@ -842,7 +992,6 @@ func writeLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 }
 
 func (cc *ClientConn) writePacket(m *msg.RelayMsgBuf) error {
-	var lr utils.LimitRate
 	var encodedHeader []byte
 	var encodedData []byte
 	var err error
@@ -872,7 +1021,7 @@ func (cc *ClientConn) writePacket(m *msg.RelayMsgBuf) error {
 	}
 
 	// pack the header and send it out
-	encodedHeader, err = core.Pack(key, packet.GetHeader())
+	encodedHeader, err = core.Pack(key, core.HeaderWithTraceContext(m, packet.GetHeader()))
 	if err != nil {
 		return errors.Wrap(err, "server cannot encrypt header")
 	}
@@ -883,7 +1032,7 @@ func (cc *ClientConn) writePacket(m *msg.RelayMsgBuf) error {
 	cc.secondWriteFlowA = cc.secondWriteAtomA.AddAndGetNew(int64(len(encodedHeader)))
 
 	// pack the second part and send it out
-	encodedData, err = core.Pack(key, packet.GetBytesAfterHeader())
+	encodedData, err = core.PackWithCompression(key, packet.GetBytesAfterHeader(), cc.compressionThreshold)
 	if err != nil {
 		return errors.Wrap(err, "server cannot encrypt msg")
 	}
@@ -897,12 +1046,7 @@ func (cc *ClientConn) writePacket(m *msg.RelayMsgBuf) error {
 			break
 		}
 		cc.secondWriteFlowA = cc.secondWriteAtomA.AddAndGetNew(int64(n))
-		if cmd == header.ReqUploadFileSlice.Id {
-			if maxUploadRate > 0 {
-				lr.SetRate(maxUploadRate)
-				lr.Limit()
-			}
-		}
+		core.ShapeUpload(cc.remoteP2pAddress, cmd, n)
 	}
 	writeEnd := time.Now()
 	costTime := writeEnd.Sub(writeStart).Milliseconds() + 1 // +1 in case of LT 1 ms
@@ -911,6 +1055,9 @@ func (cc *ClientConn) writePacket(m *msg.RelayMsgBuf) error {
 			c.Fn(cc.ctx, packet.PacketId, costTime, cc)
 		}
 	}
+	if cc.opts.onCapture != nil && err == nil {
+		cc.opts.onCapture(false, cc.remoteP2pAddress, core.SignedMessage(packet, m))
+	}
 
 	return nil
 }
@@ -946,20 +1093,24 @@ func handleLoop(c core.WriteCloser, wg *sync.WaitGroup) {
 			return
 		case msgHandler := <-handlerCh:
 			msg, handler, recvStart := msgHandler.message, msgHandler.handler, msgHandler.recvStart
-			ctxWithParentReqId := core.CreateContextWithParentReqId(ctx, msg.MSGHead.ReqId)
-			ctxWithRecvStart := core.CreateContextWithRecvStartTime(ctxWithParentReqId, recvStart)
-			ctx = core.CreateContextWithMessage(ctxWithRecvStart, &msg)
-			ctx = core.CreateContextWithNetID(ctx, netID)
-			ctx = core.CreateContextWithSrcP2pAddr(ctx, c.(*ClientConn).remoteP2pAddress)
-			if cc.opts.onHandle != nil {
-				cc.opts.onHandle(ctx, &msg)
-			}
-			if msgType := header.GetMsgTypeFromId(msgHandler.message.MSGHead.Cmd); msgType != nil {
-				log = msgType.Name
-			}
-			if handler != nil {
-				handler(ctx, c)
-			}
+			func() {
+				ctxWithParentReqId := core.CreateContextWithParentReqId(ctx, msg.MSGHead.ReqId)
+				ctxWithRecvStart := core.CreateContextWithRecvStartTime(ctxWithParentReqId, recvStart)
+				ctx := core.CreateContextWithMessage(ctxWithRecvStart, &msg)
+				ctx = core.CreateContextWithNetID(ctx, netID)
+				ctx = core.CreateContextWithSrcP2pAddr(ctx, c.(*ClientConn).remoteP2pAddress)
+				ctx, span := core.TraceHandle(ctx, &msg, c.(*ClientConn).remoteP2pAddress)
+				defer span.End()
+				if cc.opts.onHandle != nil {
+					cc.opts.onHandle(ctx, &msg)
+				}
+				if msgType := header.GetMsgTypeFromId(msgHandler.message.MSGHead.Cmd); msgType != nil {
+					log = msgType.Name
+				}
+				if handler != nil {
+					handler(ctx, c)
+				}
+			}()
 		}
 	}
 }
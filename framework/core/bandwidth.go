@@ -0,0 +1,51 @@
+package core
+
+import (
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+// The slice data sent and received by all the conns of the node, server and client ones, is shaped by node-wide
+// limits, shared between the peers
+var (
+	downloadShaper = utils.NewShaper()
+	uploadShaper   = utils.NewShaper()
+)
+
+// SetBandwidthLimits sets the rates (bytes/sec) of the slice data received and sent by the node, and the caps of the
+// traffic with each peer. 0 means unlimited
+func SetBandwidthLimits(download, upload, peerDownload, peerUpload uint64) {
+	downloadShaper.SetRates(download, peerDownload)
+	uploadShaper.SetRates(upload, peerUpload)
+}
+
+// GetBandwidthLimits returns the rates (bytes/sec) set by SetBandwidthLimits
+func GetBandwidthLimits() (download, upload, peerDownload, peerUpload uint64) {
+	download, peerDownload = downloadShaper.GetRates()
+	upload, peerUpload = uploadShaper.GetRates()
+	return
+}
+
+// isSliceData the messages carrying slice data are the ones shaped by the bandwidth limits
+func isSliceData(cmd uint8) bool {
+	switch cmd {
+	case header.ReqUploadFileSlice.Id, header.ReqBackupFileSlice.Id, header.RspDownloadSlice.Id, header.RspTransferDownload.Id:
+		return true
+	default:
+		return false
+	}
+}
+
+// ShapeDownload blocks until n more bytes of the message can be received from the peer
+func ShapeDownload(peer string, cmd uint8, n int) {
+	if isSliceData(cmd) {
+		downloadShaper.Wait(peer, n)
+	}
+}
+
+// ShapeUpload blocks until n more bytes of the message can be sent to the peer
+func ShapeUpload(peer string, cmd uint8, n int) {
+	if isSliceData(cmd) {
+		uploadShaper.Wait(peer, n)
+	}
+}
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"encoding/binary"
+	"net"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	// CompressionZstd the peer accepts the messages compressed with zstd
+	CompressionZstd uint8 = 1
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(utils.MessageBeatLen))
+)
+
+// ExchangeCompression sends the compression accepted by this node at the end of a version 4 handshake, and returns the
+// compression accepted by both peers
+func ExchangeCompression(conn net.Conn, sharedKey []byte, compression uint8) (uint8, error) {
+	encryptedMsg, err := Pack(sharedKey, []byte{compression})
+	if err != nil {
+		return 0, err
+	}
+	if err = WriteFull(conn, encryptedMsg); err != nil {
+		return 0, err
+	}
+	remoteCompression, _, err := Unpack(conn, sharedKey, utils.MessageBeatLen)
+	if err != nil {
+		return 0, err
+	}
+	if len(remoteCompression) != 1 {
+		return 0, errors.Errorf("invalid compression message size [%v]", len(remoteCompression))
+	}
+	return compression & remoteCompression[0], nil
+}
+
+// negotiateCompression compresses the messages written to the client, if it accepts it too
+func (sc *ServerConn) negotiateCompression() error {
+	threshold := sc.belong.opts.compressionThreshold
+	compression := uint8(0)
+	if threshold > 0 {
+		compression = CompressionZstd
+	}
+	compression, err := ExchangeCompression(sc.spbConn, sc.sharedKey, compression)
+	if err != nil {
+		return err
+	}
+	if compression&CompressionZstd != 0 {
+		sc.compressionThreshold = threshold
+	}
+	return nil
+}
+
+// PackWithCompression packs the message compressed when it has at least compressionThreshold bytes, and compression
+// makes it smaller. A compressionThreshold of 0 disables the compression
+func PackWithCompression(privKey, plaintext []byte, compressionThreshold int) ([]byte, error) {
+	compressed := false
+	if compressionThreshold > 0 && len(plaintext) >= compressionThreshold {
+		// data which is already compressed or encrypted, like most slices, is sent raw
+		if compressedData := zstdEncoder.EncodeAll(plaintext, nil); len(compressedData) < len(plaintext) {
+			plaintext = compressedData
+			compressed = true
+		}
+	}
+	packed, err := Pack(privKey, plaintext)
+	if err != nil || !compressed {
+		return packed, err
+	}
+	length := binary.BigEndian.Uint32(packed[EncryptionNonceSize:EncryptionHeaderSize])
+	binary.BigEndian.PutUint32(packed[EncryptionNonceSize:EncryptionHeaderSize], length|EncryptionCompressedFlag)
+	return packed, nil
+}
+
+// Decompress the data of a message packed with the compressed flag. The decompressed data can't exceed maxSize
+func Decompress(data []byte, maxSize int) ([]byte, error) {
+	decompressed, err := zstdDecoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed decompressing message")
+	}
+	if len(decompressed) > maxSize {
+		return nil, errors.Errorf("decompressed message is over sized [%v]", len(decompressed))
+	}
+	return decompressed, nil
+}
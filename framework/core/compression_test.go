@@ -0,0 +1,52 @@
+package core
+
+import (
+	"bytes"
+	"crypto/rand"
+	"net"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+func TestPackWithCompression(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	compressible := bytes.Repeat([]byte("slice hash "), 1000)
+	incompressible := make([]byte, 10000)
+	_, _ = rand.Read(incompressible)
+
+	for _, test := range []struct {
+		name       string
+		data       []byte
+		threshold  int
+		compressed bool
+	}{
+		{"compressible", compressible, 1024, true},
+		{"below the threshold", compressible, len(compressible) + 1, false},
+		{"disabled", compressible, 0, false},
+		{"incompressible", incompressible, 1024, false},
+	} {
+		packed, err := PackWithCompression(key, test.data, test.threshold)
+		if err != nil {
+			t.Fatal(test.name, err)
+		}
+		if _, _, compressed := unpackEncryptionHeader(packed); compressed != test.compressed {
+			t.Fatal(test.name, "wrong compressed flag", compressed)
+		}
+
+		client, server := net.Pipe()
+		go func() {
+			_ = WriteFull(client, packed)
+		}()
+		unpacked, _, err := Unpack(server, key, utils.MessageBeatLen)
+		if err != nil {
+			t.Fatal(test.name, err)
+		}
+		if !bytes.Equal(unpacked, test.data) {
+			t.Fatal(test.name, "the unpacked data doesn't match")
+		}
+		_ = client.Close()
+		_ = server.Close()
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/yamux"
 	"github.com/pkg/errors"
 
 	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
@@ -68,6 +69,14 @@ type ServerConn struct {
 	writeHook []WriteHook
 
 	encryptMessage bool
+	// compressionThreshold the messages of at least this size are compressed, 0 when the peers didn't agree on it
+	compressionThreshold int
+
+	muxSession *yamux.Session // not nil when the conn carries multiplexed streams, each served as a conn
+
+	relayedNode  string         // P2P address of the internal node waiting on this conn for its relayed conns
+	relaySession *yamux.Session // the streams opened to the internal node for its relayed conns
+	relayStream  net.Conn       // the stream to the internal node this conn is relayed to
 }
 
 func CreateServerConn(id int64, s *Server, c net.Conn) *ServerConn {
@@ -127,7 +136,7 @@ func (sc *ServerConn) GetPort() string {
 func (sc *ServerConn) GetLocalAddr() string {
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
-	if sc.spbConn == nil || sc.spbConn.(*net.TCPConn) == nil || sc.spbConn.LocalAddr() == nil {
+	if sc.spbConn == nil || sc.spbConn.LocalAddr() == nil {
 		return ""
 	}
 	return sc.spbConn.LocalAddr().String()
@@ -137,7 +146,7 @@ func (sc *ServerConn) GetLocalAddr() string {
 func (sc *ServerConn) GetRemoteAddr() string {
 	sc.mu.Lock()
 	defer sc.mu.Unlock()
-	if sc.spbConn == nil || sc.spbConn.(*net.TCPConn) == nil || sc.spbConn.RemoteAddr() == nil {
+	if sc.spbConn == nil || sc.spbConn.RemoteAddr() == nil {
 		return ""
 	}
 	return sc.spbConn.RemoteAddr().String()
@@ -184,29 +193,30 @@ func (sc *ServerConn) handshake() (error, bool) {
 	}
 
 	switch connType {
-	case ConnTypeClient:
-		remoteServer := serverIP.String() + ":" + strconv.FormatUint(uint64(serverPort), 10)
-		sc.remoteNetworkAddress = remoteServer
-
-		// Open a new tcp connection to the remote addr from current conn
-		handshakeAddr, err := net.ResolveTCPAddr("tcp4", remoteServer)
+	case ConnTypeClient, ConnTypeClientV2, ConnTypeClientV3, ConnTypeClientV4:
+		version, err := NegotiateHandshakeVersion(connType, sc.belong.opts.p2pKey, sc.belong.opts.minHandshakeVersion)
 		if err != nil {
-			utils.ErrorLog("Couldn't resolve TCP address", err)
 			return err, false
 		}
-		handshakeConn, err := net.DialTCP("tcp", nil, handshakeAddr)
-		if err != nil {
-			utils.ErrorLog("DialTCP failed for new connection handshake", err)
-			return err, false
-		}
-		if err = handshakeConn.SetDeadline(time.Now().Add(time.Duration(utils.HandshakeTimeOut) * time.Second)); err != nil {
-			return err, false
+		// a legacy client doesn't expect the version answer
+		if connType != ConnTypeClient {
+			if err = WriteFull(sc.spbConn, []byte{version}); err != nil {
+				return err, false
+			}
 		}
 
-		// Write the connection type as first fwmsg
-		firstMessage := CreateFirstMessage(ConnTypeHandshake, nil, 0, channelId)
-		if err = WriteFull(handshakeConn, firstMessage); err != nil {
-			return err, false
+		remoteServer := remoteServerAddress(serverIP, serverPort)
+		sc.remoteNetworkAddress = remoteServer
+
+		// From version 3, the tmp key is sent in-band. Before, it is sent on a new conn to the server of the client
+		tmpKeyConn := sc.spbConn
+		if version < HandshakeVersion3 {
+			handshakeConn, err := dialBack(remoteServer, channelId)
+			if err != nil {
+				return err, false
+			}
+			defer handshakeConn.Close()
+			tmpKeyConn = handshakeConn
 		}
 
 		// Create tmp key
@@ -219,7 +229,7 @@ func (sc *ServerConn) handshake() (error, bool) {
 		if err != nil {
 			return err, false
 		}
-		if err = WriteFull(handshakeConn, append(tmpPubKeyBytes, handshakeSignature...)); err != nil {
+		if err = WriteFull(tmpKeyConn, append(tmpPubKeyBytes, handshakeSignature...)); err != nil {
 			return err, false
 		}
 
@@ -242,8 +252,15 @@ func (sc *ServerConn) handshake() (error, bool) {
 		}
 		sc.sharedKey = sharedPrivKeyBytes
 
-		// Send local p2p address
-		encryptedMsg, err := Pack(sharedPrivKeyBytes, []byte(sc.GetLocalP2pAddress()))
+		// Send local p2p address, signed with the p2p key from version 2
+		localIdentity := []byte(sc.GetLocalP2pAddress())
+		if version >= HandshakeVersion2 {
+			localIdentity, err = CreateHandshakeIdentity(sc.belong.opts.p2pKey, sc.GetLocalP2pAddress(), false, peerPubKeyBytes, tmpPubKeyBytes)
+			if err != nil {
+				return err, false
+			}
+		}
+		encryptedMsg, err := Pack(sharedPrivKeyBytes, localIdentity)
 		if err != nil {
 			return err, false
 		}
@@ -252,16 +269,30 @@ func (sc *ServerConn) handshake() (error, bool) {
 		}
 
 		// Read remote p2p address
-		p2pAddressBytes, _, err := Unpack(sc.spbConn, sharedPrivKeyBytes, utils.MessageBeatLen)
+		remoteIdentity, _, err := Unpack(sc.spbConn, sharedPrivKeyBytes, utils.MessageBeatLen)
 		if err != nil {
 			return err, false
 		}
-		sc.remoteP2pAddress = string(p2pAddressBytes)
-		if _, err = fwtypes.P2PAddressFromBech32(sc.remoteP2pAddress); err != nil {
-			return errors.Wrap(err, "incorrect P2pAddress"), false
+		if version >= HandshakeVersion2 {
+			if sc.remoteP2pAddress, err = VerifyHandshakeIdentity(remoteIdentity, true, peerPubKeyBytes, tmpPubKeyBytes); err != nil {
+				return err, false
+			}
+		} else {
+			sc.remoteP2pAddress = string(remoteIdentity)
+			if _, err = fwtypes.P2PAddressFromBech32(sc.remoteP2pAddress); err != nil {
+				return errors.Wrap(err, "incorrect P2pAddress"), false
+			}
+		}
+		if err = CheckMuxStreamP2pAddress(sc.spbConn, sc.remoteP2pAddress); err != nil {
+			return err, false
 		}
 
-		_ = handshakeConn.Close()
+		// Exchange the accepted compression from version 4
+		if version >= HandshakeVersion4 {
+			if err = sc.negotiateCompression(); err != nil {
+				return err, false
+			}
+		}
 	case ConnTypeHandshake:
 		// Read tmp key from conn
 		buffer = make([]byte, fwed25519.PubKeySize+fwed25519.SignatureSize)
@@ -281,6 +312,18 @@ func (sc *ServerConn) handshake() (error, bool) {
 		case <-time.After(utils.HandshakeTimeOut * time.Second):
 			return errors.New("Timed out when writing to client channel"), false
 		}
+	case ConnTypeMux:
+		if err = sc.acceptMux(); err != nil {
+			return err, false
+		}
+	case ConnTypeRelayListen:
+		if err = sc.acceptRelayedNode(); err != nil {
+			return err, false
+		}
+	case ConnTypeRelay:
+		if err = sc.acceptRelayedConn(); err != nil {
+			return err, false
+		}
 	default:
 		return errors.Errorf("Invalid connection type [%v]", string(buffer)), false
 	}
@@ -288,6 +331,32 @@ func (sc *ServerConn) handshake() (error, bool) {
 	return sc.spbConn.SetDeadline(time.Time{}), false // Remove handshake timeout
 }
 
+// dialBack opens the conn on which the tmp key is sent to the server of the client, for the handshake versions before 3
+func dialBack(remoteServer string, channelId uint32) (net.Conn, error) {
+	handshakeAddr, err := net.ResolveTCPAddr("tcp", remoteServer)
+	if err != nil {
+		utils.ErrorLog("Couldn't resolve TCP address", err)
+		return nil, err
+	}
+	handshakeConn, err := net.DialTCP("tcp", nil, handshakeAddr)
+	if err != nil {
+		utils.ErrorLog("DialTCP failed for new connection handshake", err)
+		return nil, err
+	}
+	if err = handshakeConn.SetDeadline(time.Now().Add(time.Duration(utils.HandshakeTimeOut) * time.Second)); err != nil {
+		_ = handshakeConn.Close()
+		return nil, err
+	}
+
+	// Write the connection type as first fwmsg
+	firstMessage := CreateFirstMessage(ConnTypeHandshake, nil, 0, channelId)
+	if err = WriteFull(handshakeConn, firstMessage); err != nil {
+		_ = handshakeConn.Close()
+		return nil, err
+	}
+	return handshakeConn, nil
+}
+
 // Start server starts readLoop, writeLoop, handleLoop
 func (sc *ServerConn) Start() {
 	sc.encryptMessage = true
@@ -302,6 +371,23 @@ func (sc *ServerConn) Start() {
 		sc.Close()
 		return
 	}
+	if sc.muxSession != nil {
+		Mylog(sc.belong.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("start mux %v -> %v", sc.spbConn.LocalAddr(), sc.spbConn.RemoteAddr()))
+		sc.serveMuxStreams()
+		sc.Close()
+		return
+	}
+	if sc.relaySession != nil {
+		Mylog(sc.belong.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("relaying %v -> %v (%v)", sc.spbConn.LocalAddr(), sc.spbConn.RemoteAddr(), sc.relayedNode))
+		sc.waitRelayedNode()
+		sc.Close()
+		return
+	}
+	if sc.relayStream != nil {
+		sc.relayData()
+		sc.Close()
+		return
+	}
 
 	Mylog(sc.belong.opts.logOpen, LOG_MODULE_START, fmt.Sprintf("start %v -> %v (%v)", sc.spbConn.LocalAddr(), sc.spbConn.RemoteAddr(), sc.remoteP2pAddress))
 	onConnect := sc.belong.opts.onConnect
@@ -349,6 +435,7 @@ func asyncWrite(c interface{}, m *fwmsg.RelayMsgBuf, ctx context.Context) (err e
 		m.MSGHead.ReqId = reqId
 	}
 	m.PacketId = GetPacketIdFromContext(ctx)
+	TraceWrite(ctx, m, c.(*ServerConn).remoteP2pAddress)
 	sendCh <- m
 	if c.(*ServerConn).belong.opts.onWrite != nil {
 		c.(*ServerConn).belong.opts.onWrite(ctx, m)
@@ -381,6 +468,15 @@ func (sc *ServerConn) Close() {
 			// if sec > 0, the data sending will continue for <sec> second and then remaining data will be dropped
 			_ = tc.SetLinger(0)
 		}
+		if sc.muxSession != nil {
+			_ = sc.muxSession.Close() // closes all the streams
+		}
+		if sc.relaySession != nil {
+			_ = sc.relaySession.Close()
+		}
+		if sc.relayStream != nil {
+			_ = sc.relayStream.Close()
+		}
 		_ = sc.spbConn.Close()
 		// cancel readLoop, writeLoop and handleLoop go-routines.
 		sc.mu.Lock()
@@ -436,6 +532,7 @@ func readLoop(c WriteCloser, wg *sync.WaitGroup) {
 	var msgH header.MessageHead
 	var msgS fwmsg.MessageSign
 	var headerBytes []byte
+	var traceContext []byte
 	var n int
 	var err error
 	var key []byte
@@ -474,6 +571,7 @@ func readLoop(c WriteCloser, wg *sync.WaitGroup) {
 					return
 				}
 				copy(msgBuf[:header.MsgHeaderLen], headerBytes[:header.MsgHeaderLen])
+				traceContext = ReadTraceContext(headerBytes)
 				msgH.Decode(msgBuf[:header.MsgHeaderLen])
 				if msgH.Version < sc.minAppVer {
 					badAppVerReqData := sc.belong.opts.onBadAppVer(msgH.Version, msgH.Cmd, sc.minAppVer)
@@ -485,7 +583,7 @@ func readLoop(c WriteCloser, wg *sync.WaitGroup) {
 				listenHeader = false
 			} else {
 				// listen to the second part: body + sign + data. They are concatenated to the header in msgBuf.
-				nonce, secondPartLen, n, err := ReadEncryptionHeader(spbConn)
+				nonce, secondPartLen, compressed, n, err := ReadEncryptionHeader(spbConn)
 				sc.increaseReadFlow(n)
 				if err != nil {
 					Mylog(sc.belong.opts.logOpen, LOG_MODULE_READLOOP, "read encrypted header err: "+err.Error())
@@ -510,6 +608,7 @@ func readLoop(c WriteCloser, wg *sync.WaitGroup) {
 						Mylog(sc.belong.opts.logOpen, LOG_MODULE_READLOOP, "fwmsg body err: "+err.Error())
 						return
 					}
+					ShapeDownload(sc.remoteP2pAddress, msgH.Cmd, n)
 				}
 
 				// handle the second part after all bytes are received
@@ -528,6 +627,14 @@ func readLoop(c WriteCloser, wg *sync.WaitGroup) {
 					} else {
 						posEnd = posBody + secondPartLen
 					}
+					if compressed {
+						secondPart, err := Decompress(msgBuf[posBody:posEnd], len(msgBuf)-int(posBody))
+						if err != nil {
+							Mylog(sc.belong.opts.logOpen, LOG_MODULE_READLOOP, "fwmsg body decompression err: "+err.Error())
+							return
+						}
+						posEnd = posBody + uint32(copy(msgBuf[posBody:], secondPart))
+					}
 
 					// verify signature
 					msgS.Decode(msgBuf[posSign : posSign+fwmsg.MsgSignLen])
@@ -537,8 +644,9 @@ func readLoop(c WriteCloser, wg *sync.WaitGroup) {
 					}
 					// fwmsg body goes to field MSGBody, data goes to field MSGData if it exists
 					msg = &fwmsg.RelayMsgBuf{
-						MSGHead: header.CopyMessageHeader(msgH),
-						MSGBody: make([]byte, posSign-posBody),
+						MSGHead:      header.CopyMessageHeader(msgH),
+						MSGBody:      make([]byte, posSign-posBody),
+						TraceContext: traceContext,
 					}
 					copy(msg.MSGBody, msgBuf[posBody:posSign])
 
@@ -550,6 +658,9 @@ func readLoop(c WriteCloser, wg *sync.WaitGroup) {
 					if c.(*ServerConn).belong.opts.onRead != nil {
 						c.(*ServerConn).belong.opts.onRead(msg)
 					}
+					if sc.belong.opts.onCapture != nil {
+						sc.belong.opts.onCapture(true, sc.remoteP2pAddress, msg)
+					}
 
 					TimeRcv = time.Now().UnixMicro()
 					handler := GetHandlerFunc(msgH.Cmd)
@@ -666,7 +777,7 @@ func (sc *ServerConn) writePacket(m *fwmsg.RelayMsgBuf) error {
 	} else {
 		key = nil
 	}
-	encodedHeader, err = Pack(key, packet.GetHeader())
+	encodedHeader, err = Pack(key, HeaderWithTraceContext(m, packet.GetHeader()))
 	if err != nil {
 		return errors.Wrap(err, "server cannot encrypt header")
 	}
@@ -678,7 +789,7 @@ func (sc *ServerConn) writePacket(m *fwmsg.RelayMsgBuf) error {
 	sc.increaseWriteFlow(len(encodedHeader))
 
 	// pack the fwmsg data
-	encodedData, err = Pack(key, packet.GetBytesAfterHeader())
+	encodedData, err = PackWithCompression(key, packet.GetBytesAfterHeader(), sc.compressionThreshold)
 	if err != nil {
 		return errors.Wrap(err, "server cannot encrypt msg")
 	}
@@ -693,6 +804,7 @@ func (sc *ServerConn) writePacket(m *fwmsg.RelayMsgBuf) error {
 			break
 		}
 		sc.increaseWriteFlow(n)
+		ShapeUpload(sc.remoteP2pAddress, cmd, n)
 	}
 	writeEnd := time.Now()
 	costTime := writeEnd.Sub(writeStart).Milliseconds() + 1 // +1 in case of LT 1 ms
@@ -702,6 +814,9 @@ func (sc *ServerConn) writePacket(m *fwmsg.RelayMsgBuf) error {
 			c.Fn(sc.ctx, packet.PacketId, costTime, sc)
 		}
 	}
+	if sc.belong.opts.onCapture != nil && err == nil {
+		sc.belong.opts.onCapture(false, sc.remoteP2pAddress, SignedMessage(packet, m))
+	}
 	return nil
 }
 
@@ -750,6 +865,8 @@ func handleLoop(c WriteCloser, wg *sync.WaitGroup) {
 					ctx := CreateContextWithMessage(ctxWithRecvStart, &msg)
 					ctx = CreateContextWithNetID(ctx, netID)
 					ctx = CreateContextWithSrcP2pAddr(ctx, sc.remoteP2pAddress)
+					ctx, span := TraceHandle(ctx, &msg, sc.remoteP2pAddress)
+					defer span.End()
 					if c.(*ServerConn).belong.opts.onHandle != nil {
 						ctx = context.WithValue(ctx, "conn_type", "server")
 						c.(*ServerConn).belong.opts.onHandle(ctx, &msg)
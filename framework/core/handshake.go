@@ -0,0 +1,118 @@
+package core
+
+import (
+	"github.com/pkg/errors"
+
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+)
+
+// ErrLegacyHandshake the remote server closed the conn without answering the handshake version, it doesn't support the
+// version proposed by the client
+var ErrLegacyHandshake = errors.New("the remote node doesn't support the proposed handshake version")
+
+// ClientConnType the conn type announcing the highest handshake version supported by the client
+func ClientConnType(version uint8) string {
+	switch {
+	case version >= HandshakeVersion4:
+		return ConnTypeClientV4
+	case version == HandshakeVersion3:
+		return ConnTypeClientV3
+	case version == HandshakeVersion2:
+		return ConnTypeClientV2
+	default:
+		return ConnTypeClient
+	}
+}
+
+// clientHandshakeVersion the highest handshake version supported by a client announcing this conn type
+func clientHandshakeVersion(connType string) uint8 {
+	switch connType {
+	case ConnTypeClientV4:
+		return HandshakeVersion4
+	case ConnTypeClientV3:
+		return HandshakeVersion3
+	case ConnTypeClientV2:
+		return HandshakeVersion2
+	default:
+		return HandshakeVersion1
+	}
+}
+
+// NegotiateHandshakeVersion the version used by the server for a client conn of this type, or an error when the
+// version isn't accepted. Versions above 1 need the P2P key to sign the transcript
+func NegotiateHandshakeVersion(connType string, p2pKey fwcryptotypes.PrivKey, minVersion uint8) (uint8, error) {
+	version := HandshakeVersion1
+	if p2pKey != nil {
+		version = clientHandshakeVersion(connType)
+		if version > LatestHandshakeVersion {
+			version = LatestHandshakeVersion
+		}
+	}
+	if version < minVersion {
+		return 0, errors.Errorf("handshake version %v is below the minimum version %v", version, minVersion)
+	}
+	return version, nil
+}
+
+// DowngradeHandshakeVersion the version proposed again after the server closed the conn on this version, or false when
+// the client shouldn't go lower. A server advertising its handshake version is never downgraded below it: the closed
+// conn may be a man in the middle forcing the handshake without P2P key
+func DowngradeHandshakeVersion(version, minVersion, advertisedVersion uint8) (uint8, bool) {
+	floor := minVersion
+	if advertisedVersion > floor {
+		floor = advertisedVersion
+	}
+	if version <= floor || version <= HandshakeVersion1 {
+		return version, false
+	}
+	return version - 1, true
+}
+
+// handshakeTranscript the message signed by a peer with its P2P key. It binds the identity to the ephemeral keys of
+// both sides, so the signature can't be replayed in another handshake, nor reflected to its signer
+func handshakeTranscript(signedByClient bool, clientTmpKey, serverTmpKey []byte) []byte {
+	role := byte(0)
+	if signedByClient {
+		role = 1
+	}
+	transcript := append([]byte(HandshakeTranscriptMessage), role)
+	transcript = append(transcript, clientTmpKey...)
+	return append(transcript, serverTmpKey...)
+}
+
+// CreateHandshakeIdentity the identity sent at the end of a version 2 handshake: P2P public key (32) + signature of the
+// transcript (64) + P2P address
+func CreateHandshakeIdentity(p2pKey fwcryptotypes.PrivKey, p2pAddress string, isClient bool, clientTmpKey, serverTmpKey []byte) ([]byte, error) {
+	signature, err := p2pKey.Sign(handshakeTranscript(isClient, clientTmpKey, serverTmpKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed signing the handshake transcript")
+	}
+	var identity []byte
+	identity = append(identity, p2pKey.PubKey().Bytes()...)
+	identity = append(identity, signature...)
+	return append(identity, []byte(p2pAddress)...), nil
+}
+
+// VerifyHandshakeIdentity returns the P2P address of the remote peer, after checking it matches the P2P key which
+// signed the transcript
+func VerifyHandshakeIdentity(identity []byte, fromClient bool, clientTmpKey, serverTmpKey []byte) (string, error) {
+	if len(identity) <= fwed25519.PubKeySize+fwed25519.SignatureSize {
+		return "", errors.Errorf("handshake identity too small (%v bytes)", len(identity))
+	}
+	pubKey := fwed25519.PubKeyFromBytes(identity[:fwed25519.PubKeySize])
+	signature := identity[fwed25519.PubKeySize : fwed25519.PubKeySize+fwed25519.SignatureSize]
+	p2pAddress := string(identity[fwed25519.PubKeySize+fwed25519.SignatureSize:])
+
+	if _, err := fwtypes.P2PAddressFromBech32(p2pAddress); err != nil {
+		return "", errors.Wrap(err, "incorrect P2pAddress")
+	}
+	if fwtypes.P2PAddress(pubKey.Address()).String() != p2pAddress {
+		return "", errors.Errorf("the P2P key of the peer doesn't match its address %v", p2pAddress)
+	}
+	if !pubKey.VerifySignature(handshakeTranscript(fromClient, clientTmpKey, serverTmpKey), signature) {
+		return "", errors.Errorf("invalid handshake signature from %v", p2pAddress)
+	}
+	return p2pAddress, nil
+}
@@ -0,0 +1,96 @@
+package core
+
+import (
+	"testing"
+
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+)
+
+func TestHandshakeIdentity(t *testing.T) {
+	p2pKey := fwed25519.GenPrivKey()
+	p2pAddress := fwtypes.P2PAddress(p2pKey.PubKey().Address()).String()
+	clientTmpKey := fwed25519.GenPrivKey().PubKey().Bytes()
+	serverTmpKey := fwed25519.GenPrivKey().PubKey().Bytes()
+
+	identity, err := CreateHandshakeIdentity(p2pKey, p2pAddress, true, clientTmpKey, serverTmpKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	remoteAddress, err := VerifyHandshakeIdentity(identity, true, clientTmpKey, serverTmpKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remoteAddress != p2pAddress {
+		t.Fatal("wrong P2P address", remoteAddress)
+	}
+
+	if _, err = VerifyHandshakeIdentity(identity, false, clientTmpKey, serverTmpKey); err == nil {
+		t.Fatal("the identity of the client shouldn't be accepted from the server")
+	}
+	if _, err = VerifyHandshakeIdentity(identity, true, serverTmpKey, clientTmpKey); err == nil {
+		t.Fatal("the identity shouldn't be accepted in another handshake")
+	}
+
+	otherAddress := fwtypes.P2PAddress(fwed25519.GenPrivKey().PubKey().Address()).String()
+	identity, err = CreateHandshakeIdentity(p2pKey, otherAddress, true, clientTmpKey, serverTmpKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = VerifyHandshakeIdentity(identity, true, clientTmpKey, serverTmpKey); err == nil {
+		t.Fatal("an address not matching the P2P key shouldn't be accepted")
+	}
+}
+
+func TestNegotiateHandshakeVersion(t *testing.T) {
+	p2pKey := fwed25519.GenPrivKey()
+	if version, err := NegotiateHandshakeVersion(ConnTypeClientV4, p2pKey, HandshakeVersion1); err != nil || version != HandshakeVersion4 {
+		t.Fatal("wrong version", version, err)
+	}
+	if version, err := NegotiateHandshakeVersion(ConnTypeClientV3, p2pKey, HandshakeVersion1); err != nil || version != HandshakeVersion3 {
+		t.Fatal("wrong version", version, err)
+	}
+	if version, err := NegotiateHandshakeVersion(ConnTypeClientV2, p2pKey, HandshakeVersion1); err != nil || version != HandshakeVersion2 {
+		t.Fatal("wrong version", version, err)
+	}
+	if version, err := NegotiateHandshakeVersion(ConnTypeClientV3, nil, HandshakeVersion1); err != nil || version != HandshakeVersion1 {
+		t.Fatal("wrong version", version, err)
+	}
+	if version, err := NegotiateHandshakeVersion(ConnTypeClient, p2pKey, HandshakeVersion1); err != nil || version != HandshakeVersion1 {
+		t.Fatal("wrong version", version, err)
+	}
+	if _, err := NegotiateHandshakeVersion(ConnTypeClient, p2pKey, HandshakeVersion2); err == nil {
+		t.Fatal("a legacy client shouldn't be accepted")
+	}
+}
+
+func TestDowngradeHandshakeVersion(t *testing.T) {
+	tests := []struct {
+		name              string
+		version           uint8
+		minVersion        uint8
+		advertisedVersion uint8
+		downgraded        bool
+	}{
+		{"unknown peer", HandshakeVersion4, HandshakeVersion1, 0, true},
+		{"unknown peer at v1", HandshakeVersion1, HandshakeVersion1, 0, false},
+		{"minimum version", HandshakeVersion2, HandshakeVersion2, 0, false},
+		{"known v2 peer above v2", HandshakeVersion4, HandshakeVersion1, HandshakeVersion2, true},
+		{"known v2 peer", HandshakeVersion2, HandshakeVersion1, HandshakeVersion2, false},
+		{"known v4 peer", HandshakeVersion4, HandshakeVersion1, HandshakeVersion4, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			version, downgraded := DowngradeHandshakeVersion(test.version, test.minVersion, test.advertisedVersion)
+			if downgraded != test.downgraded {
+				t.Fatal("wrong downgrade", version, downgraded)
+			}
+			if downgraded && version != test.version-1 {
+				t.Fatal("wrong downgraded version", version)
+			}
+			if !downgraded && version != test.version {
+				t.Fatal("the version shouldn't change", version)
+			}
+		})
+	}
+}
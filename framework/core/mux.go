@@ -0,0 +1,283 @@
+package core
+
+import (
+	"crypto/rand"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/yamux"
+	"github.com/pkg/errors"
+
+	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	// MuxVersion the server answers a mux conn with the version of the multiplexing protocol (1 byte)
+	MuxVersion uint8 = 1
+
+	muxAcceptBacklog       = 256
+	muxMaxStreamWindowSize = 16 * 1024 * 1024
+	muxKeepAliveInterval   = 15 * time.Second
+	// muxConnectionWriteTimeout a frame can carry a whole stream window, it must have time to be sent on a slow link
+	muxConnectionWriteTimeout = 2 * time.Minute
+	muxStreamCloseTimeout     = 30 * time.Second
+	// muxRetryInterval after a peer refused a mux conn, the conns to it aren't multiplexed during this interval
+	muxRetryInterval = 10 * time.Minute
+	// muxFailureRetryInterval after a mux conn to a peer failed otherwise, eg: timed out, the conns to it aren't
+	// multiplexed during this interval, so they don't wait for the handshake timeout again
+	muxFailureRetryInterval = time.Minute
+	muxChallengeSize        = 32
+)
+
+// The mux transport carries the conns to a peer as streams of a single TCP connection. Each stream has its own flow
+// control, and closing it leaves the other streams open. The mux conn is authenticated once when it is set up: each
+// side sends a challenge, and signs both challenges with its P2P key. The streams still run their own handshake, which
+// must prove the same P2P address as the mux conn
+
+func muxConfig() *yamux.Config {
+	return &yamux.Config{
+		AcceptBacklog:          muxAcceptBacklog,
+		EnableKeepAlive:        true,
+		KeepAliveInterval:      muxKeepAliveInterval,
+		ConnectionWriteTimeout: muxConnectionWriteTimeout,
+		MaxStreamWindowSize:    muxMaxStreamWindowSize,
+		StreamOpenTimeout:      time.Duration(utils.HandshakeTimeOut) * time.Second,
+		StreamCloseTimeout:     muxStreamCloseTimeout,
+		LogOutput:              io.Discard,
+	}
+}
+
+// MuxStreamConn a stream of a mux conn, used as the net.Conn of a ServerConn or a ClientConn
+type MuxStreamConn struct {
+	*yamux.Stream
+	// sessionP2pAddress the P2P address proved by the peer when the mux conn was set up, empty for the relayed streams
+	sessionP2pAddress string
+}
+
+// SessionP2pAddress the P2P address the handshake of the stream must prove, or an empty string when it isn't bound
+func (c *MuxStreamConn) SessionP2pAddress() string {
+	return c.sessionP2pAddress
+}
+
+// Close closes the stream, and unblocks its pending reads without waiting for the peer to close its side. The mux
+// conn stays open for the other streams
+func (c *MuxStreamConn) Close() error {
+	_ = c.Stream.SetReadDeadline(time.Now())
+	return c.Stream.Close()
+}
+
+// serveMuxStreams serves each stream of the mux conn with the server, like a new conn, until the mux conn is closed
+func (sc *ServerConn) serveMuxStreams() {
+	for {
+		stream, err := sc.muxSession.AcceptStream()
+		if err != nil {
+			return
+		}
+		sc.belong.serveConn(&MuxStreamConn{Stream: stream, sessionP2pAddress: sc.remoteP2pAddress})
+	}
+}
+
+// acceptMux authenticates both sides of a mux conn, then starts serving its streams
+func (sc *ServerConn) acceptMux() error {
+	p2pKey := sc.belong.opts.p2pKey
+	if p2pKey == nil {
+		return errors.New("the P2P key is needed to accept mux conns")
+	}
+	// the streams can't be multiplexed again
+	if _, ok := sc.spbConn.(*net.TCPConn); !ok {
+		return errors.New("only a TCP conn can carry multiplexed streams")
+	}
+
+	clientChallenge := make([]byte, muxChallengeSize)
+	if _, err := io.ReadFull(sc.spbConn, clientChallenge); err != nil {
+		return err
+	}
+	serverChallenge := make([]byte, muxChallengeSize)
+	if _, err := rand.Read(serverChallenge); err != nil {
+		return err
+	}
+	identity, err := CreateHandshakeIdentity(p2pKey, sc.GetLocalP2pAddress(), false, clientChallenge, serverChallenge)
+	if err != nil {
+		return err
+	}
+	if err = WriteFull(sc.spbConn, serverChallenge); err != nil {
+		return err
+	}
+	if err = writeRelayField(sc.spbConn, identity); err != nil {
+		return err
+	}
+	if identity, err = readRelayField(sc.spbConn); err != nil {
+		return err
+	}
+	if sc.remoteP2pAddress, err = VerifyHandshakeIdentity(identity, true, clientChallenge, serverChallenge); err != nil {
+		return err
+	}
+
+	if err = WriteFull(sc.spbConn, []byte{MuxVersion}); err != nil {
+		return err
+	}
+	if err = sc.spbConn.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	sc.muxSession, err = yamux.Server(sc.spbConn, muxConfig())
+	return err
+}
+
+// CheckMuxStreamP2pAddress checks the handshake of a stream proved the P2P address of its mux conn
+func CheckMuxStreamP2pAddress(conn net.Conn, p2pAddress string) error {
+	stream, ok := conn.(*MuxStreamConn)
+	if !ok || stream.sessionP2pAddress == "" || stream.sessionP2pAddress == p2pAddress {
+		return nil
+	}
+	return errors.Errorf("the stream is from %v instead of %v, the peer of its mux conn", p2pAddress, stream.sessionP2pAddress)
+}
+
+// MuxDialer opens a stream for each conn, on a mux conn shared by all the conns to the same address
+type MuxDialer struct {
+	p2pKey     fwcryptotypes.PrivKey
+	p2pAddress string
+
+	mu    sync.Mutex
+	peers map[string]*muxPeer
+}
+
+type muxPeer struct {
+	mu          sync.Mutex
+	session     *yamux.Session
+	p2pAddress  string // proved by the peer when the mux conn was set up
+	refusedTime time.Time
+	failedTime  time.Time
+}
+
+// NewMuxDialer creates a dialer proving the P2P address with the P2P key when setting up the mux conns
+func NewMuxDialer(p2pKey fwcryptotypes.PrivKey, p2pAddress string) *MuxDialer {
+	return &MuxDialer{p2pKey: p2pKey, p2pAddress: p2pAddress, peers: make(map[string]*muxPeer)}
+}
+
+func (d *MuxDialer) Dial(addr string) (net.Conn, error) {
+	session, p2pAddress, err := d.session(addr)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := session.OpenStream()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening mux stream to "+addr)
+	}
+	return &MuxStreamConn{Stream: stream, sessionP2pAddress: p2pAddress}, nil
+}
+
+// session returns the mux conn to the address and the P2P address of the peer, dialing it if there is none or if it
+// was closed
+func (d *MuxDialer) session(addr string) (*yamux.Session, string, error) {
+	d.mu.Lock()
+	peer, ok := d.peers[addr]
+	if !ok {
+		peer = &muxPeer{}
+		d.peers[addr] = peer
+	}
+	d.mu.Unlock()
+
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	if peer.session != nil && !peer.session.IsClosed() {
+		return peer.session, peer.p2pAddress, nil
+	}
+	if time.Since(peer.refusedTime) < muxRetryInterval {
+		return nil, "", errors.Errorf("%v refused a mux conn at %v", addr, peer.refusedTime.Format(time.RFC3339))
+	}
+	if time.Since(peer.failedTime) < muxFailureRetryInterval {
+		return nil, "", errors.Errorf("the mux conn to %v failed at %v", addr, peer.failedTime.Format(time.RFC3339))
+	}
+	session, p2pAddress, refused, err := d.dialMux(addr)
+	if err != nil {
+		if refused {
+			peer.refusedTime = time.Now()
+		} else {
+			peer.failedTime = time.Now()
+		}
+		return nil, "", err
+	}
+	peer.session = session
+	peer.p2pAddress = p2pAddress
+	return session, p2pAddress, nil
+}
+
+// dialMux opens a mux conn to the address, and returns the P2P address proved by the peer. refused is true when the
+// peer closed the conn instead of answering, because it doesn't support multiplexing
+func (d *MuxDialer) dialMux(addr string) (session *yamux.Session, p2pAddress string, refused bool, err error) {
+	if d.p2pKey == nil {
+		return nil, "", false, errors.New("the P2P key is needed to open mux conns")
+	}
+	handshakeTimeout := time.Duration(utils.HandshakeTimeOut) * time.Second
+	conn, err := net.DialTimeout("tcp", addr, handshakeTimeout)
+	if err != nil {
+		return nil, "", false, errors.Wrap(err, "failed dialing mux conn to "+addr)
+	}
+	defer func() {
+		if err != nil {
+			_ = conn.Close()
+		}
+	}()
+	if err = conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return nil, "", false, err
+	}
+	clientChallenge := make([]byte, muxChallengeSize)
+	if _, err = rand.Read(clientChallenge); err != nil {
+		return nil, "", false, err
+	}
+	if err = WriteFull(conn, append(CreateFirstMessage(ConnTypeMux, nil, 0, 0), clientChallenge...)); err != nil {
+		return nil, "", false, err
+	}
+	serverChallenge := make([]byte, muxChallengeSize)
+	if _, err = io.ReadFull(conn, serverChallenge); err != nil {
+		// a legacy server closes the conn, since it doesn't know the conn type
+		refused = err == io.EOF || err == io.ErrUnexpectedEOF || errors.Is(err, syscall.ECONNRESET)
+		return nil, "", refused, errors.Wrap(err, "no answer to the mux conn from "+addr)
+	}
+	identity, err := readRelayField(conn)
+	if err != nil {
+		return nil, "", false, errors.Wrap(err, "no identity in the mux conn from "+addr)
+	}
+	if p2pAddress, err = VerifyHandshakeIdentity(identity, false, clientChallenge, serverChallenge); err != nil {
+		return nil, "", false, err
+	}
+	if identity, err = CreateHandshakeIdentity(d.p2pKey, d.p2pAddress, true, clientChallenge, serverChallenge); err != nil {
+		return nil, "", false, err
+	}
+	if err = writeRelayField(conn, identity); err != nil {
+		return nil, "", false, err
+	}
+	buffer := make([]byte, 1)
+	if _, err = io.ReadFull(conn, buffer); err != nil {
+		return nil, "", false, errors.Wrap(err, addr+" didn't accept the identity of the mux conn")
+	}
+	if buffer[0] != MuxVersion {
+		return nil, "", true, errors.Errorf("unsupported mux version %v from %v", buffer[0], addr)
+	}
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		return nil, "", false, err
+	}
+	session, err = yamux.Client(conn, muxConfig())
+	if err != nil {
+		return nil, "", false, errors.Wrap(err, "failed creating mux session to "+addr)
+	}
+	return session, p2pAddress, false, nil
+}
+
+// Close closes the mux conns, and all their streams
+func (d *MuxDialer) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for addr, peer := range d.peers {
+		peer.mu.Lock()
+		if peer.session != nil {
+			_ = peer.session.Close()
+		}
+		peer.mu.Unlock()
+		delete(d.peers, addr)
+	}
+}
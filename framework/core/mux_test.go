@@ -0,0 +1,178 @@
+package core
+
+import (
+	"io"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/yamux"
+
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+)
+
+func newTestMuxDialer() *MuxDialer {
+	p2pKey := fwed25519.GenPrivKey()
+	return NewMuxDialer(p2pKey, fwtypes.P2PAddress(p2pKey.PubKey().Address()).String())
+}
+
+// listenMux accepts mux conns and echoes their streams. A legacy listener closes the conns, like a node without
+// multiplexing
+func listenMux(t *testing.T, legacy bool) net.Listener {
+	p2pKey := fwed25519.GenPrivKey()
+	p2pAddress := fwtypes.P2PAddress(p2pKey.PubKey().Address()).String()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			buffer := make([]byte, ConnFirstMsgSize)
+			if _, err = io.ReadFull(conn, buffer); err != nil || legacy {
+				_ = conn.Close()
+				continue
+			}
+			if connType, _, _, _, err := ParseFirstMessage(buffer); err != nil || connType != ConnTypeMux {
+				_ = conn.Close()
+				continue
+			}
+			clientChallenge := make([]byte, muxChallengeSize)
+			serverChallenge := make([]byte, muxChallengeSize)
+			_, _ = io.ReadFull(conn, clientChallenge)
+			identity, _ := CreateHandshakeIdentity(p2pKey, p2pAddress, false, clientChallenge, serverChallenge)
+			_ = WriteFull(conn, serverChallenge)
+			_ = writeRelayField(conn, identity)
+			if identity, err = readRelayField(conn); err != nil {
+				_ = conn.Close()
+				continue
+			}
+			if _, err = VerifyHandshakeIdentity(identity, true, clientChallenge, serverChallenge); err != nil {
+				_ = conn.Close()
+				continue
+			}
+			_ = WriteFull(conn, []byte{MuxVersion})
+			session, err := yamux.Server(conn, muxConfig())
+			if err != nil {
+				_ = conn.Close()
+				continue
+			}
+			go func() {
+				for {
+					stream, err := session.AcceptStream()
+					if err != nil {
+						return
+					}
+					go func() {
+						defer stream.Close()
+						_, _ = io.Copy(stream, stream)
+					}()
+				}
+			}()
+		}
+	}()
+	return listener
+}
+
+func TestMuxStreams(t *testing.T) {
+	listener := listenMux(t, false)
+	defer listener.Close()
+
+	dialer := newTestMuxDialer()
+	defer dialer.Close()
+	var conns []*MuxStreamConn
+	for _, message := range []string{"first stream", "second stream"} {
+		conn, err := dialer.Dial(listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns = append(conns, conn.(*MuxStreamConn))
+		if err = WriteFull(conn, []byte(message)); err != nil {
+			t.Fatal(err)
+		}
+		buffer := make([]byte, len(message))
+		if _, err = io.ReadFull(conn, buffer); err != nil {
+			t.Fatal(err)
+		}
+		if string(buffer) != message {
+			t.Fatal("wrong echo", string(buffer))
+		}
+	}
+	if conns[0].Session() != conns[1].Session() || conns[0].StreamID() == conns[1].StreamID() {
+		t.Fatal("the conns should be streams of the same mux conn")
+	}
+	if conns[0].SessionP2pAddress() == "" {
+		t.Fatal("the streams should be bound to the P2P address proved by the peer")
+	}
+	if CheckMuxStreamP2pAddress(conns[0], conns[0].SessionP2pAddress()) != nil ||
+		CheckMuxStreamP2pAddress(conns[0], fwtypes.P2PAddress(fwed25519.GenPrivKey().PubKey().Address()).String()) == nil {
+		t.Fatal("the handshake of a stream should prove the P2P address of its mux conn")
+	}
+
+	_ = conns[0].Close()
+	if _, err := conns[0].Read(make([]byte, 1)); err == nil {
+		t.Fatal("a closed stream shouldn't be readable")
+	}
+	if err := WriteFull(conns[1], []byte("still open")); err != nil {
+		t.Fatal("closing a stream shouldn't close the other streams", err)
+	}
+	buffer := make([]byte, len("still open"))
+	if _, err := io.ReadFull(conns[1], buffer); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMuxLegacyPeer(t *testing.T) {
+	listener := listenMux(t, true)
+	defer listener.Close()
+
+	dialer := newTestMuxDialer()
+	defer dialer.Close()
+	if _, err := dialer.Dial(listener.Addr().String()); err == nil {
+		t.Fatal("a legacy peer shouldn't accept a mux conn")
+	}
+	if dialer.peers[listener.Addr().String()].refusedTime.IsZero() {
+		t.Fatal("the legacy peer should be remembered, so the next conns aren't multiplexed")
+	}
+}
+
+func TestMuxAuthentication(t *testing.T) {
+	serverKey := fwed25519.GenPrivKey()
+	serverAddress := fwtypes.P2PAddress(serverKey.PubKey().Address()).String()
+	server := CreateServer(P2pKeyOption(serverKey), P2pAddressOption(serverAddress))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = server.Start(listener)
+	}()
+	defer server.Stop()
+
+	dialer := newTestMuxDialer()
+	defer dialer.Close()
+	conn, err := dialer.Dial(listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn.(*MuxStreamConn).SessionP2pAddress() != serverAddress {
+		t.Fatal("wrong P2P address of the mux conn", conn.(*MuxStreamConn).SessionP2pAddress())
+	}
+
+	// the address doesn't match the P2P key of the dialer
+	impostor := NewMuxDialer(fwed25519.GenPrivKey(), serverAddress)
+	defer impostor.Close()
+	if _, err = impostor.Dial(listener.Addr().String()); err == nil {
+		t.Fatal("a mux conn with a wrong identity shouldn't be accepted")
+	}
+	peer := impostor.peers[listener.Addr().String()]
+	if peer.failedTime.IsZero() || !peer.refusedTime.IsZero() {
+		t.Fatal("the failure should be remembered, without taking the peer for a legacy node")
+	}
+	if _, err = impostor.Dial(listener.Addr().String()); err == nil {
+		t.Fatal("the failed peer shouldn't be dialed again right away")
+	}
+}
@@ -0,0 +1,242 @@
+package core
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/quic-go/quic-go"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	QuicAlpn = "sds"
+
+	quicMaxIncomingStreams         = 1024
+	quicMaxStreamReceiveWindow     = 48 * 1024 * 1024 // more than a slice, so a slice transfer is never throttled by flow control
+	quicMaxConnectionReceiveWindow = 256 * 1024 * 1024
+	quicKeepAlivePeriod            = 15 * time.Second
+	quicMaxIdleTimeout             = 60 * time.Second
+	quicAcceptBacklog              = 64
+	// quicRetryInterval after failing to reach an address with QUIC, it isn't dialed again during this interval, so the
+	// conns fall back to TCP without waiting for the dial timeout
+	quicRetryInterval = 10 * time.Minute
+)
+
+// The QUIC transport carries each conn on its own stream, so a peer only needs one QUIC connection, and a lost packet
+// only delays the stream it belongs to. The conns are authenticated by the handshake on the stream, like TCP conns:
+// TLS is only required by QUIC, and uses a self-signed certificate which isn't verified
+
+func quicConfig() *quic.Config {
+	return &quic.Config{
+		MaxIncomingStreams:             quicMaxIncomingStreams,
+		MaxStreamReceiveWindow:         quicMaxStreamReceiveWindow,
+		MaxConnectionReceiveWindow:     quicMaxConnectionReceiveWindow,
+		KeepAlivePeriod:                quicKeepAlivePeriod,
+		MaxIdleTimeout:                 quicMaxIdleTimeout,
+		HandshakeIdleTimeout:           time.Duration(utils.HandshakeTimeOut) * time.Second,
+		InitialStreamReceiveWindow:     quicMaxStreamReceiveWindow / 8,
+		InitialConnectionReceiveWindow: quicMaxConnectionReceiveWindow / 8,
+	}
+}
+
+func quicServerTlsConfig() (*tls.Config, error) {
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed generating the QUIC certificate key")
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(10, 0, 0),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, privKey.Public(), privKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating the QUIC certificate")
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{certificate}, PrivateKey: privKey}},
+		NextProtos:   []string{QuicAlpn},
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+func quicClientTlsConfig() *tls.Config {
+	return &tls.Config{
+		InsecureSkipVerify: true, // the peer is authenticated by the handshake of the conn
+		NextProtos:         []string{QuicAlpn},
+		MinVersion:         tls.VersionTLS13,
+	}
+}
+
+// QuicStreamConn a QUIC stream, used as the net.Conn of a ServerConn or a ClientConn
+type QuicStreamConn struct {
+	quic.Stream
+	conn quic.Connection
+}
+
+func (c *QuicStreamConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *QuicStreamConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// Close closes both directions of the stream. The QUIC connection stays open for the other streams
+func (c *QuicStreamConn) Close() error {
+	c.Stream.CancelRead(0)
+	return c.Stream.Close()
+}
+
+// QuicListener a net.Listener accepting the streams of all the QUIC connections, so a Server can serve them like TCP conns
+type QuicListener struct {
+	listener *quic.Listener
+	streams  chan net.Conn
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func ListenQuic(addr string) (*QuicListener, error) {
+	tlsConfig, err := quicServerTlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	listener, err := quic.ListenAddr(addr, tlsConfig, quicConfig())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed listening to QUIC address "+addr)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &QuicListener{
+		listener: listener,
+		streams:  make(chan net.Conn, quicAcceptBacklog),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	go l.acceptConnections()
+	return l, nil
+}
+
+func (l *QuicListener) acceptConnections() {
+	for {
+		conn, err := l.listener.Accept(l.ctx)
+		if err != nil {
+			return
+		}
+		go l.acceptStreams(conn)
+	}
+}
+
+func (l *QuicListener) acceptStreams(conn quic.Connection) {
+	for {
+		stream, err := conn.AcceptStream(l.ctx)
+		if err != nil {
+			return
+		}
+		select {
+		case l.streams <- &QuicStreamConn{Stream: stream, conn: conn}:
+		case <-l.ctx.Done():
+			stream.CancelRead(0)
+			stream.CancelWrite(0)
+			return
+		}
+	}
+}
+
+func (l *QuicListener) Accept() (net.Conn, error) {
+	select {
+	case stream := <-l.streams:
+		return stream, nil
+	case <-l.ctx.Done():
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *QuicListener) Close() error {
+	l.cancel()
+	return l.listener.Close()
+}
+
+func (l *QuicListener) Addr() net.Addr {
+	return l.listener.Addr()
+}
+
+// QuicDialer opens a stream for each conn, on a QUIC connection shared by all the conns to the same address
+type QuicDialer struct {
+	mu    sync.Mutex
+	peers map[string]*quicPeer
+}
+
+type quicPeer struct {
+	mu         sync.Mutex
+	conn       quic.Connection
+	failedTime time.Time
+}
+
+func NewQuicDialer() *QuicDialer {
+	return &QuicDialer{peers: make(map[string]*quicPeer)}
+}
+
+func (d *QuicDialer) Dial(addr string) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(utils.HandshakeTimeOut)*time.Second)
+	defer cancel()
+
+	conn, err := d.connection(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening QUIC stream to "+addr)
+	}
+	return &QuicStreamConn{Stream: stream, conn: conn}, nil
+}
+
+// connection returns the QUIC connection to the address, dialing it if there is none or if it was closed
+func (d *QuicDialer) connection(ctx context.Context, addr string) (quic.Connection, error) {
+	d.mu.Lock()
+	peer, ok := d.peers[addr]
+	if !ok {
+		peer = &quicPeer{}
+		d.peers[addr] = peer
+	}
+	d.mu.Unlock()
+
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+	if peer.conn != nil && peer.conn.Context().Err() == nil {
+		return peer.conn, nil
+	}
+	if time.Since(peer.failedTime) < quicRetryInterval {
+		return nil, errors.Errorf("QUIC address %v was unreachable at %v", addr, peer.failedTime.Format(time.RFC3339))
+	}
+	conn, err := quic.DialAddr(ctx, addr, quicClientTlsConfig(), quicConfig())
+	if err != nil {
+		peer.failedTime = time.Now()
+		return nil, errors.Wrap(err, "failed dialing QUIC address "+addr)
+	}
+	peer.conn = conn
+	return conn, nil
+}
+
+// Close closes the QUIC connections, and all their streams
+func (d *QuicDialer) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for addr, peer := range d.peers {
+		peer.mu.Lock()
+		if peer.conn != nil {
+			_ = peer.conn.CloseWithError(0, "")
+		}
+		peer.mu.Unlock()
+		delete(d.peers, addr)
+	}
+}
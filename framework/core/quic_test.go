@@ -0,0 +1,55 @@
+package core
+
+import (
+	"io"
+	"testing"
+)
+
+func TestQuicStreams(t *testing.T) {
+	listener, err := ListenQuic("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	dialer := NewQuicDialer()
+	defer dialer.Close()
+	var conns []*QuicStreamConn
+	for _, message := range []string{"first stream", "second stream"} {
+		conn, err := dialer.Dial(listener.Addr().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns = append(conns, conn.(*QuicStreamConn))
+		if err = WriteFull(conn, []byte(message)); err != nil {
+			t.Fatal(err)
+		}
+		buffer := make([]byte, len(message))
+		if _, err = io.ReadFull(conn, buffer); err != nil {
+			t.Fatal(err)
+		}
+		if string(buffer) != message {
+			t.Fatal("wrong echo", string(buffer))
+		}
+	}
+	if conns[0].conn != conns[1].conn || conns[0].StreamID() == conns[1].StreamID() {
+		t.Fatal("the conns should be streams of the same QUIC connection")
+	}
+
+	_ = conns[0].Close()
+	if _, err = conns[1].Write([]byte("still open")); err != nil {
+		t.Fatal("closing a stream shouldn't close the other streams", err)
+	}
+}
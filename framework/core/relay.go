@@ -0,0 +1,263 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	"github.com/hashicorp/yamux"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	// RelayVersion the relay answers a relay conn with the version of the relay protocol (1 byte)
+	RelayVersion uint8 = 1
+
+	relayChallengeSize = 32
+	relayMaxFieldSize  = 1024
+)
+
+// An internal node, which can't accept conns, keeps a relay listen conn to a reachable node. The relay opens a stream
+// on it for each conn relayed to the internal node, and copies the data between them. The relayed conns are
+// encrypted and authenticated end to end by their own handshake, so the relay can't read nor forge them. The internal
+// node proves its P2P address to the relay by signing a challenge, so no other node can take its conns
+
+// writeRelayField writes a field prefixed by its length (2 bytes)
+func writeRelayField(conn net.Conn, field []byte) error {
+	buffer := make([]byte, 2, 2+len(field))
+	binary.BigEndian.PutUint16(buffer, uint16(len(field)))
+	return WriteFull(conn, append(buffer, field...))
+}
+
+func readRelayField(conn net.Conn) ([]byte, error) {
+	buffer := make([]byte, 2)
+	if _, err := io.ReadFull(conn, buffer); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint16(buffer)
+	if size > relayMaxFieldSize {
+		return nil, errors.Errorf("relay field too large (%v bytes)", size)
+	}
+	field := make([]byte, size)
+	if _, err := io.ReadFull(conn, field); err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+// acceptRelayedNode registers the internal node of a relay listen conn, after checking its P2P address
+func (sc *ServerConn) acceptRelayedNode() error {
+	s := sc.belong
+	if s.opts.relayCapacity == 0 {
+		return errors.New("this node isn't a relay")
+	}
+	if _, ok := sc.spbConn.(*net.TCPConn); !ok {
+		return errors.New("only a TCP conn can wait for relayed conns")
+	}
+
+	challenge := make([]byte, relayChallengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return err
+	}
+	if err := WriteFull(sc.spbConn, challenge); err != nil {
+		return err
+	}
+	identity, err := readRelayField(sc.spbConn)
+	if err != nil {
+		return err
+	}
+	p2pAddress, err := VerifyHandshakeIdentity(identity, true, nil, challenge)
+	if err != nil {
+		return err
+	}
+
+	s.relayMu.Lock()
+	_, replaced := s.relays[p2pAddress]
+	full := !replaced && len(s.relays) >= s.opts.relayCapacity
+	s.relayMu.Unlock()
+	if full {
+		return errors.Errorf("the relay is full, %v can't be relayed", p2pAddress)
+	}
+	if err = WriteFull(sc.spbConn, []byte{RelayVersion}); err != nil {
+		return err
+	}
+	if err = sc.spbConn.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	session, err := yamux.Client(sc.spbConn, muxConfig())
+	if err != nil {
+		return err
+	}
+
+	// a node reconnecting to the relay replaces its previous conn
+	s.relayMu.Lock()
+	previous := s.relays[p2pAddress]
+	s.relays[p2pAddress] = session
+	s.relayMu.Unlock()
+	if previous != nil {
+		_ = previous.Close()
+	}
+	sc.relayedNode = p2pAddress
+	sc.relaySession = session
+	return nil
+}
+
+// waitRelayedNode keeps the internal node registered until its relay listen conn is closed
+func (sc *ServerConn) waitRelayedNode() {
+	<-sc.relaySession.CloseChan()
+	s := sc.belong
+	s.relayMu.Lock()
+	if s.relays[sc.relayedNode] == sc.relaySession {
+		delete(s.relays, sc.relayedNode)
+	}
+	s.relayMu.Unlock()
+}
+
+// acceptRelayedConn opens the stream to the internal node the relayed conn is for
+func (sc *ServerConn) acceptRelayedConn() error {
+	s := sc.belong
+	if s.opts.relayCapacity == 0 {
+		return errors.New("this node isn't a relay")
+	}
+	target, err := readRelayField(sc.spbConn)
+	if err != nil {
+		return err
+	}
+	s.relayMu.Lock()
+	session := s.relays[string(target)]
+	s.relayMu.Unlock()
+	if session == nil {
+		return errors.Errorf("%v isn't relayed by this node", string(target))
+	}
+	stream, err := session.OpenStream()
+	if err != nil {
+		return errors.Wrap(err, "failed opening relayed stream to "+string(target))
+	}
+	if err = WriteFull(sc.spbConn, []byte{RelayVersion}); err != nil {
+		_ = stream.Close()
+		return err
+	}
+	sc.relayStream = &MuxStreamConn{Stream: stream}
+	return nil
+}
+
+// relayData copies the data between the relayed conn and the stream to the internal node, until one side is closed
+func (sc *ServerConn) relayData() {
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(sc.relayStream, sc.spbConn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(sc.spbConn, sc.relayStream)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// ListenRelay waits for the conns relayed by the node at relayAddr, and serves them like the conns accepted by the
+// listeners. It returns when the relay listen conn is closed, so the caller can connect again
+func (s *Server) ListenRelay(relayAddr string) error {
+	if s.ctx.Err() != nil {
+		return utils.ErrServerClosed
+	}
+	if s.opts.p2pKey == nil {
+		return errors.New("the P2P key is needed to be relayed")
+	}
+	handshakeTimeout := time.Duration(utils.HandshakeTimeOut) * time.Second
+	conn, err := net.DialTimeout("tcp", relayAddr, handshakeTimeout)
+	if err != nil {
+		return errors.Wrap(err, "failed dialing relay "+relayAddr)
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return err
+	}
+	if err = WriteFull(conn, CreateFirstMessage(ConnTypeRelayListen, nil, 0, 0)); err != nil {
+		return err
+	}
+	challenge := make([]byte, relayChallengeSize)
+	if _, err = io.ReadFull(conn, challenge); err != nil {
+		return errors.Wrap(err, "no challenge from relay "+relayAddr)
+	}
+	identity, err := CreateHandshakeIdentity(s.opts.p2pKey, s.opts.p2pAddress, true, nil, challenge)
+	if err != nil {
+		return err
+	}
+	if err = writeRelayField(conn, identity); err != nil {
+		return err
+	}
+	if err = readRelayAnswer(conn, relayAddr); err != nil {
+		return err
+	}
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	session, err := yamux.Server(conn, muxConfig())
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+	go func() {
+		select {
+		case <-s.ctx.Done():
+			_ = session.Close()
+		case <-session.CloseChan():
+		}
+	}()
+
+	Mylog(s.opts.logOpen, LOG_MODULE_SERVER, "relayed by "+relayAddr)
+	for {
+		stream, err := session.AcceptStream()
+		if err != nil {
+			return errors.Wrap(err, "lost the conn to relay "+relayAddr)
+		}
+		s.serveConn(&MuxStreamConn{Stream: stream})
+	}
+}
+
+// DialRelay opens a conn to the internal node with this P2P address, through its relay
+func DialRelay(relayAddr, p2pAddress string) (net.Conn, error) {
+	handshakeTimeout := time.Duration(utils.HandshakeTimeOut) * time.Second
+	conn, err := net.DialTimeout("tcp", relayAddr, handshakeTimeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed dialing relay "+relayAddr)
+	}
+	if err = requestRelayedConn(conn, relayAddr, p2pAddress, handshakeTimeout); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+func requestRelayedConn(conn net.Conn, relayAddr, p2pAddress string, handshakeTimeout time.Duration) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return err
+	}
+	if err := WriteFull(conn, CreateFirstMessage(ConnTypeRelay, nil, 0, 0)); err != nil {
+		return err
+	}
+	if err := writeRelayField(conn, []byte(p2pAddress)); err != nil {
+		return err
+	}
+	if err := readRelayAnswer(conn, relayAddr); err != nil {
+		return err
+	}
+	return conn.SetDeadline(time.Time{})
+}
+
+// readRelayAnswer the relay closes the conn when it refuses it
+func readRelayAnswer(conn net.Conn, relayAddr string) error {
+	buffer := make([]byte, 1)
+	if _, err := io.ReadFull(conn, buffer); err != nil {
+		return errors.Wrap(err, "refused by relay "+relayAddr)
+	}
+	if buffer[0] != RelayVersion {
+		return errors.Errorf("unsupported relay version %v from %v", buffer[0], relayAddr)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	fwed25519 "github.com/stratosnet/sds/framework/crypto/ed25519"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+)
+
+func TestRelay(t *testing.T) {
+	relayKey := fwed25519.GenPrivKey()
+	relay := CreateServer(P2pKeyOption(relayKey), P2pAddressOption(fwtypes.P2PAddress(relayKey.PubKey().Address()).String()), RelayCapacityOption(1))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = relay.Start(listener)
+	}()
+	defer relay.Stop()
+
+	internalKey := fwed25519.GenPrivKey()
+	internalAddress := fwtypes.P2PAddress(internalKey.PubKey().Address()).String()
+	internal := CreateServer(P2pKeyOption(internalKey), P2pAddressOption(internalAddress))
+	go func() {
+		_ = internal.ListenRelay(listener.Addr().String())
+	}()
+	defer internal.Stop()
+
+	for i := 0; ; i++ {
+		relay.relayMu.Lock()
+		relayed := relay.relays[internalAddress] != nil
+		relay.relayMu.Unlock()
+		if relayed {
+			break
+		}
+		if i == 100 {
+			t.Fatal("the internal node should be relayed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the internal node answers the handshake of the relayed conn
+	conn, err := DialRelay(listener.Addr().String(), internalAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if err = WriteFull(conn, CreateFirstMessage(ConnTypeClientV3, net.ParseIP("127.0.0.1"), 1, 0)); err != nil {
+		t.Fatal(err)
+	}
+	version := make([]byte, HandshakeVersionSize)
+	if _, err = io.ReadFull(conn, version); err != nil {
+		t.Fatal(err)
+	}
+	if version[0] != HandshakeVersion3 {
+		t.Fatal("wrong handshake version", version[0])
+	}
+
+	otherAddress := fwtypes.P2PAddress(fwed25519.GenPrivKey().PubKey().Address()).String()
+	if _, err = DialRelay(listener.Addr().String(), otherAddress); err == nil {
+		t.Fatal("a node which isn't relayed shouldn't be reached")
+	}
+}
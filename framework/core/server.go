@@ -10,7 +10,9 @@ import (
 	"time"
 
 	"github.com/alex023/clock"
+	"github.com/hashicorp/yamux"
 
+	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
 	"github.com/stratosnet/sds/framework/metrics"
 	"github.com/stratosnet/sds/framework/msg"
 	"github.com/stratosnet/sds/framework/utils"
@@ -31,6 +33,9 @@ type onHandleFunc func(context.Context, *msg.RelayMsgBuf)
 type onCloseFunc func(WriteCloser)
 type onErrorFunc func(WriteCloser)
 type onBadAppVerFunc func(version uint16, cmd uint8, minAppVer uint16) []byte
+
+// CaptureFunc receives the messages written and read by the conns, decrypted, with the P2P address of the peer
+type CaptureFunc func(inbound bool, remoteP2pAddress string, m *msg.RelayMsgBuf)
 type ContextKV struct {
 	Key   interface{}
 	Value interface{}
@@ -43,14 +48,22 @@ type options struct {
 	onClose        onCloseFunc
 	onError        onErrorFunc
 	onBadAppVer    onBadAppVerFunc
+	onCapture      CaptureFunc
 	bufferSize     int
 	logOpen        bool
 	maxConnections int
 	maxflow        int
 	minAppVersion  uint16
 	p2pAddress     string
-	contextkv      []ContextKV
-	readTimeout    int64
+	p2pKey         fwcryptotypes.PrivKey
+	// minHandshakeVersion client conns with a lower handshake version are rejected
+	minHandshakeVersion uint8
+	// relayCapacity number of internal nodes relayed by this server, 0 when it isn't a relay
+	relayCapacity int
+	// compressionThreshold the messages of at least this size are compressed, when the peer accepts it. 0 disables it
+	compressionThreshold int
+	contextkv            []ContextKV
+	readTimeout          int64
 }
 
 type ServerOption func(*options)
@@ -66,6 +79,9 @@ type Server struct {
 	goroutine  int64
 	goAtom     *utils.AtomicInt64
 	volRecOpts volRecOpts
+
+	relayMu sync.Mutex
+	relays  map[string]*yamux.Session // the mux conns of the relayed nodes, by P2P address
 }
 
 const (
@@ -98,6 +114,7 @@ func CreateServer(opt ...ServerOption) *Server {
 		wg:     &sync.WaitGroup{},
 		lis:    make(map[net.Listener]bool),
 		goAtom: utils.CreateAtomicInt64(0),
+		relays: make(map[string]*yamux.Session),
 	}
 	s.ctx, s.cancel = context.WithCancel(context.Background())
 	for _, kv := range s.opts.contextkv {
@@ -211,43 +228,53 @@ func (s *Server) Start(l net.Listener) error {
 		}
 		// tempDelay = 0
 
-		sz := s.ConnsSize()
-		if s.opts.maxConnections != 0 {
-			if sz >= s.opts.maxConnections {
-				utils.ErrorLog("max connections size", sz, "refuse\n")
-				spbConn.Close()
-				continue
-			}
+		s.serveConn(spbConn)
+	}
+}
+
+// serveConn starts a ServerConn for a conn accepted by a listener, or for a stream of a mux conn
+func (s *Server) serveConn(spbConn net.Conn) {
+	if s.conns == nil {
+		// the server is stopping, a mux conn can still be accepting streams
+		spbConn.Close()
+		return
+	}
+	sz := s.ConnsSize()
+	if s.opts.maxConnections != 0 {
+		if sz >= s.opts.maxConnections {
+			utils.ErrorLog("max connections size", sz, "refuse\n")
+			spbConn.Close()
+			return
 		}
-		//utils.DebugLog("MaxConnections", s.opts.maxConnections)
-		netid := netID.GetOldAndIncrement()
-		sc := CreateServerConn(netid, s, spbConn)
-		sc.SetConnName(sc.spbConn.RemoteAddr().String())
-		metrics.ConnReconnection.WithLabelValues(strings.Split(sc.GetName(), ":")[0]).Inc()
-		metrics.ConnNumbers.WithLabelValues("server").Inc()
-
-		// s.mu.Lock()
-		// if s.sched != nil {
-		// 	sc.RunEvery(s.interv, s.sched)
-		// }
-		// s.mu.Unlock()
-
-		s.conns.Store(netid, sc)
-		// addTotalConn(1)
-		s.wg.Add(1) // this will be Done() in ServerConn.Close()
-		s.goroutine = s.goAtom.IncrementAndGetNew()
-		go func() {
-			sc.Start()
-		}()
-
-		Mylog(s.opts.logOpen, LOG_MODULE_SERVER, fmt.Sprintf("accepted client %v id: %v total: %v", sc.GetName(), netid, s.ConnsSize()))
-		// s.conns.Range(func(k, v interface{}) bool {
-		// 	i := k.(int64)
-		// 	c := v.(*ServerConn)
-		// 	Mylog(s.opts.logOpen,"client(%d) %s", i, c.GetName())
-		// 	return true
-		// })
 	}
+	//utils.DebugLog("MaxConnections", s.opts.maxConnections)
+	netid := netID.GetOldAndIncrement()
+	sc := CreateServerConn(netid, s, spbConn)
+	sc.SetConnName(sc.spbConn.RemoteAddr().String())
+	metrics.ConnReconnection.WithLabelValues(sc.GetIP()).Inc()
+	metrics.ConnNumbers.WithLabelValues("server").Inc()
+
+	// s.mu.Lock()
+	// if s.sched != nil {
+	// 	sc.RunEvery(s.interv, s.sched)
+	// }
+	// s.mu.Unlock()
+
+	s.conns.Store(netid, sc)
+	// addTotalConn(1)
+	s.wg.Add(1) // this will be Done() in ServerConn.Close()
+	s.goroutine = s.goAtom.IncrementAndGetNew()
+	go func() {
+		sc.Start()
+	}()
+
+	Mylog(s.opts.logOpen, LOG_MODULE_SERVER, fmt.Sprintf("accepted client %v id: %v total: %v", sc.GetName(), netid, s.ConnsSize()))
+	// s.conns.Range(func(k, v interface{}) bool {
+	// 	i := k.(int64)
+	// 	c := v.(*ServerConn)
+	// 	Mylog(s.opts.logOpen,"client(%d) %s", i, c.GetName())
+	// 	return true
+	// })
 }
 
 func (s *Server) Stop() {
@@ -373,6 +400,52 @@ func P2pAddressOption(p2pAddress string) ServerOption {
 	}
 }
 
+// P2pKeyOption sets the P2P key proving the local P2P address during the handshake
+func P2pKeyOption(p2pKey fwcryptotypes.PrivKey) ServerOption {
+	return func(o *options) {
+		o.p2pKey = p2pKey
+	}
+}
+
+// RelayCapacityOption sets how many internal nodes can be reached through this server
+func RelayCapacityOption(capacity int) ServerOption {
+	return func(o *options) {
+		o.relayCapacity = capacity
+	}
+}
+
+// CaptureOption passes each message written or read by the conns to cb, once it is sent or received entirely
+func CaptureOption(cb CaptureFunc) ServerOption {
+	return func(o *options) {
+		o.onCapture = cb
+	}
+}
+
+// SignedMessage the message as it was sent: the header and signature of the packet, with the body and data of the message
+func SignedMessage(packet, m *msg.RelayMsgBuf) *msg.RelayMsgBuf {
+	return &msg.RelayMsgBuf{
+		PacketId:     packet.PacketId,
+		MSGHead:      packet.MSGHead,
+		MSGSign:      packet.MSGSign,
+		MSGBody:      m.MSGBody,
+		MSGData:      m.MSGData,
+		TraceContext: m.TraceContext,
+	}
+}
+
+// CompressionOption compresses the messages of at least threshold bytes, on the conns of the peers accepting it
+func CompressionOption(threshold int) ServerOption {
+	return func(o *options) {
+		o.compressionThreshold = threshold
+	}
+}
+
+func MinHandshakeVersionOption(version uint8) ServerOption {
+	return func(o *options) {
+		o.minHandshakeVersion = version
+	}
+}
+
 func ReadDeadlineOption(timeout int64) ServerOption {
 	return func(o *options) {
 		o.readTimeout = timeout
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	fwmsg "github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+)
+
+const (
+	// TraceContextLen the trace context of the span sending a message, carried after its header: trace ID (16) +
+	// span ID (8) + trace flags (1). The nodes which don't trace the messages only read the header and ignore it
+	TraceContextLen = 16 + 8 + 1
+
+	tracerName = "github.com/stratosnet/sds/framework/core"
+)
+
+// tracer the spans are recorded by the global tracer provider, when the node sets one
+var tracer = otel.Tracer(tracerName)
+
+// EncodeTraceContext the trace context carried by a message, or nil when the span context isn't valid
+func EncodeTraceContext(spanContext trace.SpanContext) []byte {
+	if !spanContext.IsValid() {
+		return nil
+	}
+	traceID := spanContext.TraceID()
+	spanID := spanContext.SpanID()
+	traceContext := make([]byte, 0, TraceContextLen)
+	traceContext = append(traceContext, traceID[:]...)
+	traceContext = append(traceContext, spanID[:]...)
+	return append(traceContext, byte(spanContext.TraceFlags()))
+}
+
+// DecodeTraceContext the remote span context of the trace context carried by a message
+func DecodeTraceContext(traceContext []byte) trace.SpanContext {
+	if len(traceContext) < TraceContextLen {
+		return trace.SpanContext{}
+	}
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	copy(traceID[:], traceContext[:16])
+	copy(spanID[:], traceContext[16:24])
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.TraceFlags(traceContext[24]),
+		Remote:     true,
+	})
+}
+
+func messageSpanName(prefix string, cmd uint8) string {
+	if msgType := header.GetMsgTypeFromId(cmd); msgType != nil {
+		return prefix + " " + msgType.Name
+	}
+	return prefix + " message"
+}
+
+func messageAttributes(m *fwmsg.RelayMsgBuf, remoteP2pAddress string) trace.SpanStartEventOption {
+	return trace.WithAttributes(
+		attribute.Int("sds.cmd", int(m.MSGHead.Cmd)),
+		attribute.Int64("sds.req_id", m.MSGHead.ReqId),
+		attribute.Int64("sds.packet_id", m.PacketId),
+		attribute.Int("sds.body_size", len(m.MSGBody)),
+		attribute.Int("sds.data_size", len(m.MSGData)),
+		attribute.String("sds.peer", remoteP2pAddress),
+	)
+}
+
+// TraceWrite records the span sending the message, and puts its context in the message for the receiver. Like the
+// producer of a queue, the span ends when the message is queued to the conn
+func TraceWrite(ctx context.Context, m *fwmsg.RelayMsgBuf, remoteP2pAddress string) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, span := tracer.Start(ctx, messageSpanName("send", m.MSGHead.Cmd), trace.WithSpanKind(trace.SpanKindProducer),
+		messageAttributes(m, remoteP2pAddress))
+	m.TraceContext = EncodeTraceContext(span.SpanContext())
+	span.End()
+}
+
+// TraceHandle starts the span handling the message, as a child of the span which sent it. The spans started from the
+// returned context, like the messages written by the handler, belong to the same trace
+func TraceHandle(ctx context.Context, m *fwmsg.RelayMsgBuf, remoteP2pAddress string) (context.Context, trace.Span) {
+	if spanContext := DecodeTraceContext(m.TraceContext); spanContext.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, spanContext)
+	}
+	return tracer.Start(ctx, messageSpanName("handle", m.MSGHead.Cmd), trace.WithSpanKind(trace.SpanKindConsumer),
+		messageAttributes(m, remoteP2pAddress))
+}
+
+// HeaderWithTraceContext the header of the message, followed by its trace context if any
+func HeaderWithTraceContext(m *fwmsg.RelayMsgBuf, packetHeader []byte) []byte {
+	if len(m.TraceContext) != TraceContextLen {
+		return packetHeader
+	}
+	return append(append(make([]byte, 0, len(packetHeader)+TraceContextLen), packetHeader...), m.TraceContext...)
+}
+
+// ReadTraceContext the trace context following the header, or nil when the sender didn't trace the message
+func ReadTraceContext(headerBytes []byte) []byte {
+	if len(headerBytes) < header.MsgHeaderLen+TraceContextLen {
+		return nil
+	}
+	return append([]byte(nil), headerBytes[header.MsgHeaderLen:header.MsgHeaderLen+TraceContextLen]...)
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+
+	fwmsg "github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+)
+
+func TestTraceContext(t *testing.T) {
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceFlags: trace.FlagsSampled,
+	})
+	m := &fwmsg.RelayMsgBuf{TraceContext: EncodeTraceContext(spanContext)}
+	if len(m.TraceContext) != TraceContextLen {
+		t.Fatal("wrong trace context length", len(m.TraceContext))
+	}
+
+	packetHeader := make([]byte, header.MsgHeaderLen)
+	headerBytes := HeaderWithTraceContext(m, packetHeader)
+	if !bytes.Equal(headerBytes[:header.MsgHeaderLen], packetHeader) {
+		t.Fatal("the header must come first, for the nodes which don't trace the messages")
+	}
+	decoded := DecodeTraceContext(ReadTraceContext(headerBytes))
+	if !decoded.IsRemote() || decoded.TraceID() != spanContext.TraceID() || decoded.SpanID() != spanContext.SpanID() ||
+		!decoded.IsSampled() {
+		t.Fatal("wrong decoded span context", decoded)
+	}
+
+	// a message from a node which doesn't trace the messages
+	if ReadTraceContext(packetHeader) != nil || DecodeTraceContext(nil).IsValid() {
+		t.Fatal("a header without trace context must give an invalid span context")
+	}
+	if EncodeTraceContext(trace.SpanContext{}) != nil {
+		t.Fatal("an invalid span context must not be sent")
+	}
+}
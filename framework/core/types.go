@@ -7,13 +7,38 @@ const (
 	// Read the first message from the connection. It should indicate what kind of connection it is
 	ConnFirstMsgSize  = 30 // Conn type (8) + IP (16) + server port (2) + channel ID (4)
 	ConnTypeClient    = "client__"
+	ConnTypeClientV2  = "clientv2"
+	ConnTypeClientV3  = "clientv3"
+	ConnTypeClientV4  = "clientv4"
 	ConnTypeHandshake = "handshke"
+	ConnTypeMux       = "mux_____" // the conn carries the conns of the client as multiplexed streams
+	// ConnTypeRelayListen an internal node waiting on this conn for the conns relayed to it by the server
+	ConnTypeRelayListen = "relaylsn"
+	// ConnTypeRelay a conn to an internal node, relayed by the server
+	ConnTypeRelay = "relay___"
 
-	HandshakeMessage = "sds_handshake"
+	HandshakeMessage           = "sds_handshake"
+	HandshakeTranscriptMessage = "sds_handshake_v2"
+
+	// HandshakeVersion1 the remote P2P address is only declared by the peer
+	HandshakeVersion1 uint8 = 1
+	// HandshakeVersion2 the remote P2P address is proven by signing the handshake transcript with the P2P key
+	HandshakeVersion2 uint8 = 2
+	// HandshakeVersion3 the tmp key of the server is sent on the conn itself, instead of a new conn dialed back to the
+	// client, so clients behind a NAT or a firewall can connect
+	HandshakeVersion3 uint8 = 3
+	// HandshakeVersion4 the peers exchange the compression they accept after their identities
+	HandshakeVersion4 uint8 = 4
+	// LatestHandshakeVersion the highest handshake version supported by this node
+	LatestHandshakeVersion = HandshakeVersion4
+	// HandshakeVersionSize the server answers a client conn with the negotiated handshake version (1 byte)
+	HandshakeVersionSize = 1
 
 	EncryptionHeaderSize = EncryptionNonceSize + EncryptionLengthSize // Nonce (8) + data length (4)
 	EncryptionNonceSize  = 8
 	EncryptionLengthSize = 4
+	// EncryptionCompressedFlag the highest bit of the data length is set when the data is compressed
+	EncryptionCompressedFlag uint32 = 1 << 31
 )
 
 type WriteHookFunc func(ctx context.Context, packetId, costTime int64, conn WriteCloser)
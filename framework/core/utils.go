@@ -5,6 +5,7 @@ import (
 	"io"
 	"math/rand"
 	"net"
+	"strconv"
 
 	"github.com/pkg/errors"
 
@@ -46,6 +47,11 @@ func ParseFirstMessage(data []byte) (string, net.IP, uint16, uint32, error) {
 	return connType, ip, serverPort, channelId, nil
 }
 
+// remoteServerAddress the address of the server announced in the first message of a client, IPv6 ones are bracketed
+func remoteServerAddress(ip net.IP, port uint16) string {
+	return net.JoinHostPort(ip.String(), strconv.FormatUint(uint64(port), 10))
+}
+
 func Pack(privKey, plaintext []byte) ([]byte, error) {
 	// set nonce to 0 when message is non-encrypted packed
 	packHead := make([]byte, EncryptionHeaderSize)
@@ -69,28 +75,28 @@ func Pack(privKey, plaintext []byte) ([]byte, error) {
 
 }
 
-func unpackEncryptionHeader(data []byte) (uint64, uint32) {
+func unpackEncryptionHeader(data []byte) (uint64, uint32, bool) {
 	if len(data) < EncryptionHeaderSize {
-		return 0, 0
+		return 0, 0, false
 	}
 
 	nonce := binary.BigEndian.Uint64(data[:EncryptionNonceSize])
 	length := binary.BigEndian.Uint32(data[EncryptionNonceSize:])
 
-	return nonce, length
+	return nonce, length &^ EncryptionCompressedFlag, length&EncryptionCompressedFlag != 0
 }
 
-func ReadEncryptionHeader(c net.Conn) (nonce uint64, dataLen uint32, bytesRead int, err error) {
+func ReadEncryptionHeader(c net.Conn) (nonce uint64, dataLen uint32, compressed bool, bytesRead int, err error) {
 	buffer := make([]byte, EncryptionHeaderSize)
 	if bytesRead, err = io.ReadFull(c, buffer); err != nil {
-		return 0, 0, bytesRead, err
+		return 0, 0, false, bytesRead, err
 	}
-	nonce, dataLen = unpackEncryptionHeader(buffer)
-	return nonce, dataLen, bytesRead, nil
+	nonce, dataLen, compressed = unpackEncryptionHeader(buffer)
+	return nonce, dataLen, compressed, bytesRead, nil
 }
 
 func Unpack(c net.Conn, privKey []byte, maxBodySize int) (plaintext []byte, bytesRead int, err error) {
-	nonce, dataLen, bytesRead, err := ReadEncryptionHeader(c)
+	nonce, dataLen, compressed, bytesRead, err := ReadEncryptionHeader(c)
 	if err != nil {
 		return nil, bytesRead, err
 	}
@@ -105,9 +111,14 @@ func Unpack(c net.Conn, privKey []byte, maxBodySize int) (plaintext []byte, byte
 		return nil, bytesRead, err
 	}
 
+	plaintext = buffer
 	if privKey != nil {
-		plaintext, err = encryption.DecryptAES(privKey, buffer, nonce, false)
-		return plaintext, bytesRead, err
+		if plaintext, err = encryption.DecryptAES(privKey, buffer, nonce, false); err != nil {
+			return nil, bytesRead, err
+		}
+	}
+	if compressed {
+		plaintext, err = Decompress(plaintext, maxBodySize)
 	}
-	return buffer, bytesRead, err
+	return plaintext, bytesRead, err
 }
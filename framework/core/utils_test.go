@@ -0,0 +1,54 @@
+package core
+
+import (
+	"net"
+	"testing"
+)
+
+func TestRemoteServerAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		ip      string
+		port    uint16
+		address string
+	}{
+		{"ipv4", "1.2.3.4", 18081, "1.2.3.4:18081"},
+		{"ipv6", "2001:db8::1", 18081, "[2001:db8::1]:18081"},
+		{"ipv4-mapped ipv6", "::ffff:1.2.3.4", 80, "1.2.3.4:80"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// the address goes through the first message of the client
+			_, ip, port, _, err := ParseFirstMessage(CreateFirstMessage(ConnTypeClientV4, net.ParseIP(test.ip), test.port, 0))
+			if err != nil {
+				t.Fatal(err)
+			}
+			address := remoteServerAddress(ip, port)
+			if address != test.address {
+				t.Fatalf("wrong address %v, expected %v", address, test.address)
+			}
+			if _, err = net.ResolveTCPAddr("tcp", address); err != nil {
+				t.Fatal("the address should be dialable", err)
+			}
+		})
+	}
+}
+
+func TestServerConnIP(t *testing.T) {
+	tests := []struct {
+		name string
+		ip   string
+	}{
+		{"1.2.3.4:18081", "1.2.3.4"},
+		{"[2001:db8::1]:18081", "2001:db8::1"},
+		{"[fe80::1%eth0]:18081", "fe80::1%eth0"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sc := &ServerConn{name: test.name}
+			if ip := sc.GetIP(); ip != test.ip {
+				t.Fatalf("wrong ip %v, expected %v", ip, test.ip)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// ContentKeySize size of the AES-256 key encrypting the content of a file
+	ContentKeySize = 32
+	// ChunkSaltSize size of the random salt deriving the key of one encrypted stream from the content key
+	ChunkSaltSize = 16
+	// DefaultChunkSize size of the plaintext sealed in each chunk
+	DefaultChunkSize = 64 * 1024
+
+	lastChunkFlag = 1 << 31
+)
+
+// GenerateContentKey returns a random content key
+func GenerateContentKey() ([]byte, error) {
+	key := make([]byte, ContentKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// GenerateChunkSalt returns a random salt for NewChunkCipher
+func GenerateChunkSalt() ([]byte, error) {
+	salt := make([]byte, ChunkSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+// ChunkCipher seals a stream as a sequence of AES-GCM chunks, each of them can be opened as soon as it is received.
+// The index of a chunk and whether it is the last one are bound to its nonce, so chunks can't be reordered or truncated
+type ChunkCipher struct {
+	gcm cipher.AEAD
+}
+
+// NewChunkCipher derives the key of one stream from the content key and a salt. The salt must be random for each
+// stream encrypted with the same content key
+func NewChunkCipher(contentKey, salt []byte) (*ChunkCipher, error) {
+	if len(contentKey) != ContentKeySize {
+		return nil, fmt.Errorf("content key should be %v bytes", ContentKeySize)
+	}
+	mac := hmac.New(sha256.New, contentKey)
+	mac.Write(salt)
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &ChunkCipher{gcm: gcm}, nil
+}
+
+func (c *ChunkCipher) nonce(index uint32, last bool) []byte {
+	nonce := make([]byte, c.gcm.NonceSize())
+	if last {
+		index |= lastChunkFlag
+	}
+	binary.BigEndian.PutUint32(nonce[len(nonce)-4:], index)
+	return nonce
+}
+
+// Overhead number of bytes added to each chunk
+func (c *ChunkCipher) Overhead() int {
+	return c.gcm.Overhead()
+}
+
+func (c *ChunkCipher) SealChunk(dst, plaintext []byte, index uint32, last bool) ([]byte, error) {
+	if index&lastChunkFlag != 0 {
+		return nil, errors.New("too many chunks")
+	}
+	return c.gcm.Seal(dst, c.nonce(index, last), plaintext, nil), nil
+}
+
+func (c *ChunkCipher) OpenChunk(dst, ciphertext []byte, index uint32, last bool) ([]byte, error) {
+	if index&lastChunkFlag != 0 {
+		return nil, errors.New("too many chunks")
+	}
+	return c.gcm.Open(dst, c.nonce(index, last), ciphertext, nil)
+}
+
+// EncryptChunks seals plaintext in chunks of chunkSize bytes
+func EncryptChunks(contentKey, salt, plaintext []byte, chunkSize int) ([]byte, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New("invalid chunk size")
+	}
+	c, err := NewChunkCipher(contentKey, salt)
+	if err != nil {
+		return nil, err
+	}
+	chunkCount := (len(plaintext) + chunkSize - 1) / chunkSize
+	if chunkCount == 0 {
+		chunkCount = 1
+	}
+	ciphertext := make([]byte, 0, len(plaintext)+chunkCount*c.Overhead())
+	for i := 0; i < chunkCount; i++ {
+		end := (i + 1) * chunkSize
+		if end > len(plaintext) {
+			end = len(plaintext)
+		}
+		ciphertext, err = c.SealChunk(ciphertext, plaintext[i*chunkSize:end], uint32(i), i == chunkCount-1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ciphertext, nil
+}
+
+// DecryptChunks opens the chunks sealed by EncryptChunks
+func DecryptChunks(contentKey, salt, ciphertext []byte, chunkSize int) ([]byte, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New("invalid chunk size")
+	}
+	c, err := NewChunkCipher(contentKey, salt)
+	if err != nil {
+		return nil, err
+	}
+	sealedSize := chunkSize + c.Overhead()
+	chunkCount := (len(ciphertext) + sealedSize - 1) / sealedSize
+	if chunkCount == 0 {
+		return nil, errors.New("missing encrypted chunk")
+	}
+	plaintext := make([]byte, 0, len(ciphertext))
+	for i := 0; i < chunkCount; i++ {
+		end := (i + 1) * sealedSize
+		if end > len(ciphertext) {
+			end = len(ciphertext)
+		}
+		plaintext, err = c.OpenChunk(plaintext, ciphertext[i*sealedSize:end], uint32(i), i == chunkCount-1)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt chunk %v: %w", i, err)
+		}
+	}
+	return plaintext, nil
+}
+
+// WrapContentKey encrypts the content key for the owner of an Ed25519 public key. An ephemeral key pair is used for
+// the handshake, its public key is prepended to the wrapped key
+func WrapContentKey(contentKey, recipientPublicKey []byte) ([]byte, error) {
+	if len(recipientPublicKey) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid recipient public key")
+	}
+	ephemeralPublic, ephemeralPrivate, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		return nil, err
+	}
+	kek, err := keyEncryptionKey(ephemeralPrivate, recipientPublicKey, ephemeralPublic, recipientPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	// the key encryption key is only used once, so the nonce can be constant
+	wrapped, err := EncryptAES(kek, contentKey, 0)
+	if err != nil {
+		return nil, err
+	}
+	return append(ephemeralPublic, wrapped...), nil
+}
+
+// UnwrapContentKey decrypts a content key wrapped for the public key of ourPrivateKey
+func UnwrapContentKey(wrappedKey []byte, ourPrivateKey ed25519.PrivateKey) ([]byte, error) {
+	if len(wrappedKey) <= ed25519.PublicKeySize {
+		return nil, errors.New("wrapped key is too short")
+	}
+	ephemeralPublic := wrappedKey[:ed25519.PublicKeySize]
+	ourPublic := ourPrivateKey.Public().(ed25519.PublicKey)
+	kek, err := keyEncryptionKey(ourPrivateKey, ephemeralPublic, ephemeralPublic, ourPublic)
+	if err != nil {
+		return nil, err
+	}
+	contentKey, err := DecryptAES(kek, wrappedKey[ed25519.PublicKeySize:], 0, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not unwrap the content key: %w", err)
+	}
+	return contentKey, nil
+}
+
+// keyEncryptionKey hashes the shared secret together with both public keys of the handshake
+func keyEncryptionKey(ourPrivateKey, peerPublicKey, ephemeralPublic, recipientPublic []byte) ([]byte, error) {
+	shared, err := ECDH(ourPrivateKey, peerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	hash.Write(shared)
+	hash.Write(ephemeralPublic)
+	hash.Write(recipientPublic)
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,56 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+)
+
+func TestChunkEncryption(t *testing.T) {
+	key, err := GenerateContentKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt, err := GenerateChunkSalt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := make([]byte, 3*1000+10)
+	_, _ = rand.Read(message)
+
+	ciphertext, err := EncryptChunks(key, salt, message, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := DecryptChunks(key, salt, ciphertext, 1000)
+	if err != nil || !bytes.Equal(decrypted, message) {
+		t.Fatal("decrypted message doesn't match", err)
+	}
+
+	if _, err = DecryptChunks(key, salt, ciphertext[:2*(1000+16)], 1000); err == nil {
+		t.Fatal("a truncated message shouldn't be decrypted")
+	}
+	otherSalt, _ := GenerateChunkSalt()
+	if _, err = DecryptChunks(key, otherSalt, ciphertext, 1000); err == nil {
+		t.Fatal("a message shouldn't be decrypted with another salt")
+	}
+}
+
+func TestWrapContentKey(t *testing.T) {
+	key, _ := GenerateContentKey()
+	public, private, _ := ed25519.GenerateKey(nil)
+	_, otherPrivate, _ := ed25519.GenerateKey(nil)
+
+	wrapped, err := WrapContentKey(key, public)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unwrapped, err := UnwrapContentKey(wrapped, private)
+	if err != nil || !bytes.Equal(unwrapped, key) {
+		t.Fatal("unwrapped key doesn't match", err)
+	}
+	if _, err = UnwrapContentKey(wrapped, otherPrivate); err == nil {
+		t.Fatal("the key shouldn't be unwrapped by another recipient")
+	}
+}
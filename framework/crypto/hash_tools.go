@@ -19,6 +19,9 @@ const (
 	hashLen     = 20   // take 20 bytes of 32 bytes of hash
 	VIDEO_CODEC = 0x72 // VIDEO_CODEC is separate from SDS_CODEC in order to identify the videos
 	SDS_CODEC   = 0x66 // codec of legacy file hash is cid.RAW. New file hash uses SDS_CODEC.
+	// CONTENT_SLICE_CODEC identifies the hashes of the slices cut at content defined boundaries, which only depend on
+	// the slice data
+	CONTENT_SLICE_CODEC = 0x67
 
 	VALID_CID_VERSION = 1
 	VALID_MH_TYPE     = 27
@@ -162,6 +165,25 @@ func CalcSliceHash(data []byte, fileHash string, sliceNumber uint64) (string, er
 	return sliceCid.Encode(encoder), nil
 }
 
+// CalcContentSliceHash calculates the hash of a slice from its data only, so a slice shared by several files, or by
+// several versions of a file, keeps the same hash. It is used for the slices cut at content defined boundaries, and its
+// codec tells it apart from the hash of a slice at a given position of a file
+func CalcContentSliceHash(data []byte) (string, error) {
+	sliceHash, err := mh.Sum(data, mh.KECCAK_256, hashLen)
+	if err != nil {
+		return "", err
+	}
+	sliceCid := cid.NewCidV1(CONTENT_SLICE_CODEC, sliceHash)
+	encoder, _ := mbase.NewEncoder(mbase.Base32hex)
+	return sliceCid.Encode(encoder), nil
+}
+
+// IsContentSliceHash whether the slice hash was calculated by CalcContentSliceHash
+func IsContentSliceHash(sliceHash string) bool {
+	code, err := GetCodecFromFileHash(sliceHash)
+	return err == nil && code == CONTENT_SLICE_CODEC
+}
+
 func uint64ToBytes(n uint64) []byte {
 	byteBuf := bytes.NewBuffer([]byte{})
 	_ = binary.Write(byteBuf, binary.BigEndian, n)
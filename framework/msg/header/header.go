@@ -143,6 +143,8 @@ const (
 	MSG_ID_REQ_CLEAR_EXPIRED_SHARE_LINKS
 	MSG_ID_RSP_CLEAR_EXPIRED_SHARE_LINKS
 	MSG_ID_NOTICE_RELOCATE_SP
+	MSG_ID_REQ_REPORT_CORRUPTED_SLICES
+	MSG_ID_RSP_REPORT_CORRUPTED_SLICES
 	NUMBER_MESSAGE_TYPES
 )
 
@@ -277,6 +279,9 @@ var (
 	ReqClearExpiredShareLinks MsgType
 	RspClearExpiredShareLinks MsgType
 
+	ReqReportCorruptedSlices MsgType
+	RspReportCorruptedSlices MsgType
+
 	registeredMessages [NUMBER_MESSAGE_TYPES]*MsgType
 )
 
@@ -427,6 +432,9 @@ func init() {
 
 	registerOneMessageType(&ReqClearExpiredShareLinks, MSG_ID_REQ_CLEAR_EXPIRED_SHARE_LINKS, "ReqCESL")
 	registerOneMessageType(&RspClearExpiredShareLinks, MSG_ID_RSP_CLEAR_EXPIRED_SHARE_LINKS, "RspCESL")
+
+	registerOneMessageType(&ReqReportCorruptedSlices, MSG_ID_REQ_REPORT_CORRUPTED_SLICES, "ReqRCS")
+	registerOneMessageType(&RspReportCorruptedSlices, MSG_ID_RSP_REPORT_CORRUPTED_SLICES, "RspRCS")
 }
 
 func GetMsgTypeFromId(id uint8) *MsgType {
@@ -524,6 +532,8 @@ func GetReqIdFromRspId(reqId uint8) uint8 {
 		return MSG_ID_REQ_BLS_SIGNATURE
 	case MSG_ID_RSP_CLEAR_EXPIRED_SHARE_LINKS:
 		return MSG_ID_REQ_CLEAR_EXPIRED_SHARE_LINKS
+	case MSG_ID_RSP_REPORT_CORRUPTED_SLICES:
+		return MSG_ID_REQ_REPORT_CORRUPTED_SLICES
 	default:
 		return MSG_ID_INVALID
 	}
@@ -16,6 +16,8 @@ type RelayMsgBuf struct {
 	MSGSign  MessageSign
 	MSGBody  []byte
 	MSGData  []byte
+	// TraceContext the context of the span which sent the message, carried after the header
+	TraceContext []byte
 
 	Alloc *[]byte
 }
@@ -94,6 +94,20 @@ func (d DataMeshId) String() string {
 type ShareDataMeshId struct {
 	Link     string
 	Password string
+	// RecipientKeys wallets the file is shared with, and the content key of the file wrapped for each of them
+	RecipientKeys string
+}
+
+// FullLink the share link in the sds://<link>/<password>/<recipient keys> format, the last parts being optional
+func (s ShareDataMeshId) FullLink() string {
+	link := SHARED_DATA_MESH_PROTOCOL + s.Link
+	if s.Password != "" || s.RecipientKeys != "" {
+		link += "/" + s.Password
+	}
+	if s.RecipientKeys != "" {
+		link += "/" + s.RecipientKeys
+	}
+	return link
 }
 
 func GenerateNormalShareLinkV2() string {
@@ -137,9 +151,17 @@ func ParseShareLink(getShareString string) (*ShareDataMeshId, error) {
 		}, nil
 	}
 
+	if len(parts) == 2 {
+		return &ShareDataMeshId{
+			Link:     parts[0],
+			Password: parts[1],
+		}, nil
+	}
+
 	return &ShareDataMeshId{
-		Link:     parts[0],
-		Password: parts[1],
+		Link:          parts[0],
+		Password:      parts[1],
+		RecipientKeys: parts[2],
 	}, nil
 }
 
@@ -67,3 +67,21 @@ func TestDataMeshId_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParseShareLink(t *testing.T) {
+	tests := []ShareDataMeshId{
+		{Link: "0123456789abcdef_0123456789_abcdef"},
+		{Link: "0123456789abcdef_0123456789_abcdef", Password: "1234"},
+		{Link: "0123456789abcdef_0123456789_abcdef", Password: "1234", RecipientKeys: "a-b_c"},
+		{Link: "0123456789abcdef_0123456789_abcdef", RecipientKeys: "a-b_c"},
+	}
+	for _, want := range tests {
+		got, err := ParseShareLink(want.FullLink())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(*got, want) {
+			t.Errorf("ParseShareLink(%v) got = %v, want %v", want.FullLink(), *got, want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// shaperActiveTime a peer shares the global rate while it transferred data during this time
+	shaperActiveTime = 2 * time.Second
+	// shaperExpiryTime the bucket of a peer is dropped after this time without traffic
+	shaperExpiryTime = time.Minute
+	// shaperCountInterval the active peers are counted at most once per interval, not for every chunk of data
+	shaperCountInterval = 100 * time.Millisecond
+)
+
+// TokenBucket limits a flow of bytes to a rate (bytes/sec), with bursts of up to one second of traffic. A rate of 0 means
+// unlimited
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func NewTokenBucket(rate uint64) *TokenBucket {
+	return &TokenBucket{rate: float64(rate), tokens: float64(rate), last: time.Now()}
+}
+
+func (b *TokenBucket) SetRate(rate uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.rate == float64(rate) {
+		return
+	}
+	b.refill(time.Now())
+	b.rate = float64(rate)
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+}
+
+func (b *TokenBucket) GetRate() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return uint64(b.rate)
+}
+
+// Wait blocks until n bytes can be transferred
+func (b *TokenBucket) Wait(n int) {
+	if delay := b.reserve(n, time.Now()); delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+// reserve takes n tokens and returns how long to wait until they are earned. The tokens can be owed, so the next
+// reservations wait for the debt to be paid
+func (b *TokenBucket) reserve(n int, now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	if b.rate == 0 {
+		return 0
+	}
+	b.tokens -= float64(n)
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *TokenBucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		b.last = now
+	}
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+}
+
+// Shaper limits the traffic of the whole node to a global rate shared fairly between the peers: each active peer gets
+// an equal share of the global rate, capped by the peer rate. Rates are in bytes/sec, 0 means unlimited
+type Shaper struct {
+	mu          sync.Mutex
+	globalRate  uint64
+	peerRate    uint64
+	global      *TokenBucket
+	peers       map[string]*shaperPeer
+	activePeers int
+	countTime   time.Time
+}
+
+type shaperPeer struct {
+	bucket     *TokenBucket
+	lastActive time.Time
+}
+
+func NewShaper() *Shaper {
+	return &Shaper{
+		global: NewTokenBucket(0),
+		peers:  make(map[string]*shaperPeer),
+	}
+}
+
+func (s *Shaper) SetRates(globalRate, peerRate uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.globalRate = globalRate
+	s.peerRate = peerRate
+	s.global.SetRate(globalRate)
+}
+
+func (s *Shaper) GetRates() (globalRate, peerRate uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.globalRate, s.peerRate
+}
+
+// Wait blocks until n bytes can be transferred with the peer
+func (s *Shaper) Wait(peer string, n int) {
+	s.mu.Lock()
+	if s.globalRate == 0 && s.peerRate == 0 {
+		s.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	p, ok := s.peers[peer]
+	if !ok {
+		p = &shaperPeer{bucket: NewTokenBucket(0)}
+		s.peers[peer] = p
+		s.countTime = time.Time{} // count the new peer right away
+	}
+	p.lastActive = now
+	if now.Sub(s.countTime) >= shaperCountInterval {
+		s.countActivePeers(now)
+	}
+	p.bucket.SetRate(s.peerShare())
+	s.mu.Unlock()
+
+	delay := p.bucket.reserve(n, now)
+	if globalDelay := s.global.reserve(n, now); globalDelay > delay {
+		delay = globalDelay
+	}
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+// countActivePeers counts the peers which transferred data recently, and drops the ones idle for a long time
+func (s *Shaper) countActivePeers(now time.Time) {
+	s.activePeers = 0
+	for key, p := range s.peers {
+		idle := now.Sub(p.lastActive)
+		if idle < shaperActiveTime {
+			s.activePeers++
+		} else if idle > shaperExpiryTime {
+			delete(s.peers, key)
+		}
+	}
+	s.countTime = now
+}
+
+// peerShare the rate of each active peer
+func (s *Shaper) peerShare() uint64 {
+	if s.globalRate == 0 || s.activePeers == 0 {
+		return s.peerRate
+	}
+	share := s.globalRate / uint64(s.activePeers)
+	if share == 0 {
+		share = 1
+	}
+	if s.peerRate != 0 && s.peerRate < share {
+		return s.peerRate
+	}
+	return share
+}
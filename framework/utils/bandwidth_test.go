@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	bucket := NewTokenBucket(1000)
+	now := bucket.last
+	if delay := bucket.reserve(1000, now); delay != 0 {
+		t.Fatal("a burst of one second shouldn't wait", delay)
+	}
+	if delay := bucket.reserve(500, now); delay != 500*time.Millisecond {
+		t.Fatal("wrong delay", delay)
+	}
+	if delay := bucket.reserve(500, now.Add(time.Second)); delay != 0 {
+		t.Fatal("the debt should be paid after one second", delay)
+	}
+	if delay := bucket.reserve(0, now.Add(time.Hour)); delay != 0 || bucket.tokens != 1000 {
+		t.Fatal("the tokens shouldn't exceed one second of traffic", bucket.tokens)
+	}
+
+	bucket.SetRate(0)
+	if delay := bucket.reserve(1000000, now.Add(time.Hour)); delay != 0 {
+		t.Fatal("an unlimited bucket shouldn't wait", delay)
+	}
+}
+
+func TestShaperPeerShare(t *testing.T) {
+	shaper := NewShaper()
+	shaper.SetRates(1000, 0)
+	for _, peer := range []string{"a", "b", "c", "d"} {
+		shaper.Wait(peer, 1)
+	}
+	if shaper.activePeers != 4 || shaper.peerShare() != 250 {
+		t.Fatal("the global rate should be shared by the active peers", shaper.activePeers, shaper.peerShare())
+	}
+
+	shaper.SetRates(1000, 100)
+	if shaper.peerShare() != 100 {
+		t.Fatal("the share should be capped by the peer rate", shaper.peerShare())
+	}
+
+	shaper.countActivePeers(time.Now().Add(shaperExpiryTime * 2))
+	if shaper.activePeers != 0 || len(shaper.peers) != 0 {
+		t.Fatal("the idle peers should be dropped", shaper.activePeers, len(shaper.peers))
+	}
+}
@@ -56,6 +56,38 @@ type ParamUploadSign struct {
 	ReqTime        int64     `json:"req_time"`
 }
 
+// upload: request upload of a directory stored on the node
+type ParamReqUploadDirectory struct {
+	DirPath         string    `json:"dirpath"`
+	Signature       Signature `json:"signature"`
+	IsEncrypted     bool      `json:"is_encrypted,omitempty"`
+	DesiredTier     uint32    `json:"desired_tier"`
+	AllowHigherTier bool      `json:"allow_higher_tier"`
+	Concurrency     int       `json:"concurrency,omitempty"`
+	ReqTime         int64     `json:"req_time"`
+}
+
+// upload: query the result of a directory upload
+type ParamGetDirectoryUploadResult struct {
+	ReqId string `json:"reqid"`
+}
+
+// version: request a change or a query of a versioned object of the node wallet
+type ParamReqObjectVersion struct {
+	Key             string    `json:"key"`
+	FilePath        string    `json:"filepath,omitempty"` // file uploaded as the new version, for put
+	Version         uint64    `json:"version,omitempty"`  // version number, for get (0 for the latest) and rollback
+	Signature       Signature `json:"signature"`
+	DesiredTier     uint32    `json:"desired_tier,omitempty"`
+	AllowHigherTier bool      `json:"allow_higher_tier,omitempty"`
+	ReqTime         int64     `json:"req_time"`
+}
+
+// version: query the result of a versioned object request
+type ParamGetObjectVersionResult struct {
+	ReqId string `json:"reqid"`
+}
+
 // get current file status
 type ParamGetFileStatus struct {
 	FileHash  string    `json:"filehash"`
@@ -65,9 +97,16 @@ type ParamGetFileStatus struct {
 
 // download: request download file
 type ParamReqDownloadFile struct {
-	FileHandle string    `json:"filehandle"`
-	Signature  Signature `json:"signature"`
-	ReqTime    int64     `json:"req_time"`
+	FileHandle  string    `json:"filehandle"`
+	Signature   Signature `json:"signature"`
+	ReqTime     int64     `json:"req_time"`
+	Recursive   bool      `json:"recursive,omitempty"`   // the file handle is a directory manifest, restore the directory on the node
+	Concurrency int       `json:"concurrency,omitempty"` // number of files downloaded at the same time in recursive mode
+}
+
+// download: query the result of a directory restoration
+type ParamGetDirectoryDownloadResult struct {
+	ReqId string `json:"reqid"`
 }
 
 // download: download file data
@@ -117,6 +156,17 @@ type ParamReqShareFile struct {
 	PrivateFlag bool      `json:"private_flag,omitempty"`
 	ReqTime     int64     `json:"req_time"`
 	IpfsCid     string    `json:"ipfs_cid,omitempty"`
+	// the wallets the file is shared to, the share link can only be used by them. The signature then covers their
+	// addresses, see ShareFileToRecipientsWalletSignMessage
+	Recipients []ShareRecipient `json:"recipients,omitempty"`
+}
+
+// share: a wallet a file is shared to. The encryption public key, or the content key already wrapped for the wallet,
+// both in hex, are only needed when the file is encrypted with a content key
+type ShareRecipient struct {
+	WalletAddress    string `json:"wallet_address"`
+	EncryptionPubkey string `json:"encryption_pubkey,omitempty"`
+	WrappedKey       string `json:"wrapped_key,omitempty"`
 }
 
 // share: request list shared files
@@ -138,6 +188,8 @@ type ParamReqGetShared struct {
 	Signature Signature `json:"signature"`
 	ShareLink string    `json:"sharelink"`
 	ReqTime   int64     `json:"req_time"`
+	// the sequence number the signature was made with, to verify the recipient of a share link bound to wallets
+	SequenceNumber string `json:"sequence_number,omitempty"`
 }
 
 type FileInfo struct {
@@ -186,6 +238,46 @@ type FileStatusResult struct {
 	Replicas        uint32                 `json:"replicas"`
 }
 
+type DirectoryUploadResult struct {
+	Return         string            `json:"return"`
+	Detail         string            `json:"detail,omitempty"`
+	ReqId          string            `json:"reqid,omitempty"`
+	Done           bool              `json:"done"`
+	ManifestHandle string            `json:"manifest_handle,omitempty"`
+	Files          map[string]string `json:"files,omitempty"`
+	Failed         map[string]string `json:"failed,omitempty"`
+}
+
+type ObjectVersion struct {
+	Number     uint64 `json:"number"`
+	FileHandle string `json:"file_handle"`
+	FileName   string `json:"file_name"`
+	FileSize   uint64 `json:"file_size"`
+	Timestamp  int64  `json:"timestamp"`
+	RollbackOf uint64 `json:"rollback_of,omitempty"`
+}
+
+type ObjectVersionResult struct {
+	Return   string          `json:"return"`
+	Detail   string          `json:"detail,omitempty"`
+	ReqId    string          `json:"reqid,omitempty"`
+	Done     bool            `json:"done"`
+	Key      string          `json:"key,omitempty"`
+	Versions []ObjectVersion `json:"versions,omitempty"`
+	Version  *ObjectVersion  `json:"version,omitempty"`
+	Path     string          `json:"path,omitempty"` // local path of the downloaded version
+}
+
+type DirectoryDownloadResult struct {
+	Return string            `json:"return"`
+	Detail string            `json:"detail,omitempty"`
+	ReqId  string            `json:"reqid,omitempty"`
+	Done   bool              `json:"done"`
+	Path   string            `json:"path,omitempty"`
+	Files  map[string]string `json:"files,omitempty"`
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
 type FileListResult struct {
 	Return      string     `json:"return"`
 	FileInfo    []FileInfo `json:"fileinfo,omitempty"`
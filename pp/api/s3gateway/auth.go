@@ -0,0 +1,298 @@
+package s3gateway
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/pp/sigv4"
+)
+
+const (
+	maxClockSkew       = 15 * time.Minute
+	maxPresignedExpiry = 7 * 24 * time.Hour
+	maxChunkSize       = 16 * 1024 * 1024
+
+	unsignedPayload        = "UNSIGNED-PAYLOAD"
+	streamingPayload       = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	streamingUnsignedTrail = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+	emptySha256            = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// signature the parsed AWS signature version 4 of a request
+type signature struct {
+	accessKey     string
+	date          string
+	region        string
+	signedHeaders []string
+	signature     string
+	amzDate       string
+	payloadHash   string
+	presigned     bool
+}
+
+func (s *signature) scope() string {
+	return sigv4.Scope(s.date, s.region)
+}
+
+// authenticate verifies the signature of the request, from its Authorization header or from its query for a presigned
+// url, and replaces the body with a reader checking the signature of the payload
+func (g *Gateway) authenticate(r *http.Request) error {
+	var sig *signature
+	var err error
+	if r.URL.Query().Get("X-Amz-Algorithm") != "" {
+		sig, err = parsePresignedSignature(r.URL.Query())
+	} else {
+		sig, err = parseHeaderSignature(r)
+	}
+	if err != nil {
+		return err
+	}
+	if sig.accessKey != g.config.AccessKey {
+		return errInvalidAccessKeyId
+	}
+	if sig.region != g.config.Region {
+		return newApiError(errAuthorizationHeaderMalformed, "the region should be "+g.config.Region)
+	}
+	signedAt, err := time.Parse(sigv4.DateFormat, sig.amzDate)
+	if err != nil || sig.date != sig.amzDate[:8] {
+		return newApiError(errAccessDenied, "wrong signature date")
+	}
+	if sig.presigned {
+		expires, err := strconv.ParseInt(r.URL.Query().Get("X-Amz-Expires"), 10, 64)
+		if err != nil || expires < 0 || time.Duration(expires)*time.Second > maxPresignedExpiry {
+			return newApiError(errAuthorizationQueryParametersError, "wrong X-Amz-Expires")
+		}
+		if g.now().After(signedAt.Add(time.Duration(expires)*time.Second)) || signedAt.Sub(g.now()) > maxClockSkew {
+			return newApiError(errAccessDenied, "request has expired")
+		}
+	} else if g.now().Sub(signedAt) > maxClockSkew || signedAt.Sub(g.now()) > maxClockSkew {
+		return errRequestTimeTooSkewed
+	}
+
+	key := sigv4.SigningKey(g.config.SecretKey, sig.date, sig.region)
+	stringToSign := sigv4.StringToSign(sig.amzDate, sig.scope(), canonicalRequest(r, sig))
+	if !hmac.Equal([]byte(sigv4.Sign(key, stringToSign)), []byte(sig.signature)) {
+		return errSignatureDoesNotMatch
+	}
+
+	switch sig.payloadHash {
+	case unsignedPayload:
+	case streamingPayload:
+		r.Body = newChunkedReader(r.Body, &chunkSigner{key: key, amzDate: sig.amzDate, scope: sig.scope(), previous: sig.signature})
+	case streamingUnsignedTrail:
+		r.Body = newChunkedReader(r.Body, nil)
+	default:
+		if _, err = hex.DecodeString(sig.payloadHash); err != nil || len(sig.payloadHash) != sha256.Size*2 {
+			return newApiError(errInvalidArgument, "unsupported x-amz-content-sha256")
+		}
+		r.Body = &hashedReader{ReadCloser: r.Body, hash: sha256.New(), expected: sig.payloadHash}
+	}
+	return nil
+}
+
+func parseHeaderSignature(r *http.Request) (*signature, error) {
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		return nil, newApiError(errAccessDenied, "the request should be signed")
+	}
+	if !strings.HasPrefix(auth, sigv4.Algorithm+" ") {
+		return nil, newApiError(errAuthorizationHeaderMalformed, "only "+sigv4.Algorithm+" signatures are supported")
+	}
+	sig := &signature{
+		amzDate:     r.Header.Get("X-Amz-Date"),
+		payloadHash: r.Header.Get("X-Amz-Content-Sha256"),
+	}
+	if sig.payloadHash == "" {
+		return nil, newApiError(errInvalidArgument, "missing x-amz-content-sha256")
+	}
+	for _, field := range strings.Split(strings.TrimPrefix(auth, sigv4.Algorithm+" "), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+		switch name {
+		case "Credential":
+			if err := sig.parseCredential(value); err != nil {
+				return nil, err
+			}
+		case "SignedHeaders":
+			sig.signedHeaders = strings.Split(value, ";")
+		case "Signature":
+			sig.signature = value
+		}
+	}
+	if sig.accessKey == "" || len(sig.signedHeaders) == 0 || sig.signature == "" || len(sig.amzDate) != len(sigv4.DateFormat) {
+		return nil, newApiError(errAuthorizationHeaderMalformed, "incomplete signature")
+	}
+	return sig, nil
+}
+
+func parsePresignedSignature(query url.Values) (*signature, error) {
+	if query.Get("X-Amz-Algorithm") != sigv4.Algorithm {
+		return nil, newApiError(errAuthorizationQueryParametersError, "only "+sigv4.Algorithm+" signatures are supported")
+	}
+	sig := &signature{
+		signedHeaders: strings.Split(query.Get("X-Amz-SignedHeaders"), ";"),
+		signature:     query.Get("X-Amz-Signature"),
+		amzDate:       query.Get("X-Amz-Date"),
+		payloadHash:   unsignedPayload,
+		presigned:     true,
+	}
+	if err := sig.parseCredential(query.Get("X-Amz-Credential")); err != nil {
+		return nil, err
+	}
+	if sig.signature == "" || len(sig.amzDate) != len(sigv4.DateFormat) {
+		return nil, newApiError(errAuthorizationQueryParametersError, "incomplete signature")
+	}
+	return sig, nil
+}
+
+// parseCredential parses <access key>/<date>/<region>/s3/aws4_request
+func (s *signature) parseCredential(credential string) error {
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 || parts[3] != sigv4.Service || parts[4] != sigv4.Terminator {
+		return newApiError(errAuthorizationHeaderMalformed, "wrong credential scope")
+	}
+	s.accessKey, s.date, s.region = parts[0], parts[1], parts[2]
+	return nil
+}
+
+func canonicalRequest(r *http.Request, sig *signature) string {
+	query := r.URL.Query()
+	query.Del("X-Amz-Signature")
+	headerValues := make([]string, len(sig.signedHeaders))
+	for i, name := range sig.signedHeaders {
+		headerValues[i] = canonicalHeaderValue(r, name)
+	}
+	return sigv4.CanonicalRequest(r.Method, sigv4.Escape(r.URL.Path, true), query, sig.signedHeaders, headerValues, sig.payloadHash)
+}
+
+func canonicalHeaderValue(r *http.Request, name string) string {
+	switch name {
+	case "host":
+		return r.Host
+	case "content-length":
+		return strconv.FormatInt(r.ContentLength, 10)
+	}
+	var values []string
+	for _, value := range r.Header.Values(name) {
+		values = append(values, strings.Join(strings.Fields(value), " "))
+	}
+	return strings.Join(values, ",")
+}
+
+// hashedReader checks the sha256 of the payload once it has been entirely read
+type hashedReader struct {
+	io.ReadCloser
+	hash     hash.Hash
+	expected string
+}
+
+func (h *hashedReader) Read(p []byte) (int, error) {
+	n, err := h.ReadCloser.Read(p)
+	h.hash.Write(p[:n])
+	if err == io.EOF && hex.EncodeToString(h.hash.Sum(nil)) != h.expected {
+		return n, errContentSha256Mismatch
+	}
+	return n, err
+}
+
+// chunkSigner computes the signature of each chunk of a streaming payload, chained to the previous one
+type chunkSigner struct {
+	key      []byte
+	amzDate  string
+	scope    string
+	previous string
+}
+
+func (c *chunkSigner) verify(data []byte, chunkSignature string) bool {
+	stringToSign := strings.Join([]string{sigv4.Algorithm + "-PAYLOAD", c.amzDate, c.scope, c.previous, emptySha256, sigv4.Sha256Hex(data)}, "\n")
+	expected := sigv4.Sign(c.key, stringToSign)
+	c.previous = expected
+	return hmac.Equal([]byte(expected), []byte(chunkSignature))
+}
+
+// chunkedReader decodes an aws-chunked payload. The signature of each chunk is verified when there is a signer
+type chunkedReader struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+	signer *chunkSigner
+	chunk  []byte
+	err    error
+}
+
+func newChunkedReader(body io.ReadCloser, signer *chunkSigner) *chunkedReader {
+	return &chunkedReader{body: body, reader: bufio.NewReader(body), signer: signer}
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for len(c.chunk) == 0 && c.err == nil {
+		c.err = c.readChunk()
+	}
+	if len(c.chunk) == 0 {
+		return 0, c.err
+	}
+	n := copy(p, c.chunk)
+	c.chunk = c.chunk[n:]
+	return n, nil
+}
+
+func (c *chunkedReader) Close() error {
+	return c.body.Close()
+}
+
+// readChunk reads "<hex size>[;chunk-signature=<signature>]\r\n<data>\r\n". The last chunk is empty, and is followed
+// by the trailing headers of an unsigned payload
+func (c *chunkedReader) readChunk() error {
+	line, err := c.readLine()
+	if err != nil {
+		return err
+	}
+	sizeField, extension, _ := strings.Cut(line, ";")
+	size, err := strconv.ParseUint(sizeField, 16, 64)
+	if err != nil || size > maxChunkSize {
+		return newApiError(errIncompleteBody, "wrong chunk size")
+	}
+	data := make([]byte, size)
+	if _, err = io.ReadFull(c.reader, data); err != nil {
+		return errIncompleteBody
+	}
+	if c.signer != nil {
+		if !c.signer.verify(data, strings.TrimPrefix(extension, "chunk-signature=")) {
+			return errSignatureDoesNotMatch
+		}
+	}
+	if size == 0 {
+		// the trailing headers end with an empty line. A signed payload has no trailer, only the final CRLF
+		for {
+			if line, err = c.readLine(); err != nil || line == "" {
+				break
+			}
+		}
+		return io.EOF
+	}
+	if line, err = c.readLine(); err != nil || line != "" {
+		return errIncompleteBody
+	}
+	c.chunk = data
+	return nil
+}
+
+func (c *chunkedReader) readLine() (string, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		if err == io.EOF {
+			return "", errIncompleteBody
+		}
+		return "", errors.Wrap(err, "failed reading chunk")
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
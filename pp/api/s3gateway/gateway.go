@@ -0,0 +1,227 @@
+// Package s3gateway serves an S3-compatible API in front of the resource node. Objects are stored as files of the node
+// wallet, named after their bucket and key, so the objects can be listed from the file list of the wallet. The files are
+// encrypted with an encryption tag derived from the bucket and the key, so that the same content stored in two objects
+// is held by two different files. Requests must be signed (AWS signature version 4) with the key pair of the
+// configuration, since the gateway signs the SDS requests with the node wallet.
+package s3gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"hash/fnv"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	// objectNamePrefix starts the name of all the files holding objects: s3.<bucket>.<key>.<version>, the bucket and the
+	// key base64url encoded. The version, in base 36, is the time the object was stored in nanoseconds
+	objectNamePrefix = "s3."
+	objectTagPrefix  = "s3-"
+	maxKeyLength     = 1024
+	lockStripes      = 64
+	versionBase      = 36
+)
+
+var bucketNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// Gateway the S3-compatible HTTP server
+type Gateway struct {
+	config   setting.S3GatewayConfig
+	httpServ *http.Server
+	now      func() time.Time
+	// objectLocks serialize the changes of an object, and downloadLocks the downloads of a file
+	objectLocks   [lockStripes]sync.Mutex
+	downloadLocks [lockStripes]sync.Mutex
+}
+
+// StartGateway starts serving the S3 API. The context of the requests is derived from ctx
+func StartGateway(ctx context.Context, config setting.S3GatewayConfig) (*Gateway, error) {
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return nil, errors.New("the access key and the secret key of the s3 gateway should be configured")
+	}
+	listener, err := net.Listen("tcp", ":"+config.Port)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed listening for the s3 gateway")
+	}
+	g := &Gateway{config: config, now: time.Now}
+	g.httpServ = &http.Server{
+		Handler: g,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+	go func() {
+		if err := g.httpServ.Serve(listener); err != nil && err != http.ErrServerClosed {
+			utils.ErrorLog("s3 gateway stopped", err)
+		}
+	}()
+	utils.Logf("S3 gateway listening on port %v", config.Port)
+	return g, nil
+}
+
+func (g *Gateway) Stop() {
+	_ = g.httpServ.Close()
+}
+
+// ServeHTTP routes the path-style requests (/<bucket>/<key>) to the S3 operations
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	requestId := uuid.New().String()
+	w.Header().Set("x-amz-request-id", requestId)
+	w.Header().Set("Server", "SDS")
+
+	if err := g.authenticate(r); err != nil {
+		writeError(w, r, requestId, err)
+		return
+	}
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	var err error
+	switch {
+	case bucket == "":
+		err = g.serveService(w, r)
+	case !bucketNameRegexp.MatchString(bucket):
+		err = errInvalidBucketName
+	case key == "":
+		err = g.serveBucket(w, r, bucket)
+	default:
+		err = g.serveObject(w, r, bucket, key)
+	}
+	if err != nil {
+		writeError(w, r, requestId, err)
+	}
+}
+
+func (g *Gateway) serveService(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodGet {
+		return errMethodNotAllowed
+	}
+	return g.listBuckets(w, r)
+}
+
+func (g *Gateway) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) error {
+	query := r.URL.Query()
+	switch r.Method {
+	case http.MethodGet:
+		switch {
+		case query.Has("location"):
+			writeXml(w, http.StatusOK, locationConstraint{Location: g.config.Region})
+			return nil
+		case query.Get("list-type") == "2":
+			return g.listObjects(w, r, bucket)
+		}
+		return newApiError(errNotImplemented, "only ListObjectsV2 is supported to list a bucket")
+	case http.MethodHead:
+		// buckets only exist through their objects, so any bucket can be used
+		w.WriteHeader(http.StatusOK)
+		return nil
+	case http.MethodPut:
+		w.Header().Set("Location", "/"+bucket)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	case http.MethodDelete:
+		return g.deleteBucket(w, r, bucket)
+	}
+	return errMethodNotAllowed
+}
+
+func (g *Gateway) serveObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	if len(key) > maxKeyLength {
+		return errKeyTooLong
+	}
+	query := r.URL.Query()
+	uploadId := query.Get("uploadId")
+	switch r.Method {
+	case http.MethodGet:
+		if uploadId != "" {
+			return newApiError(errNotImplemented, "ListParts is not supported")
+		}
+		return g.getObject(w, r, bucket, key)
+	case http.MethodHead:
+		return g.headObject(w, r, bucket, key)
+	case http.MethodPut:
+		if r.Header.Get("X-Amz-Copy-Source") != "" {
+			return newApiError(errNotImplemented, "CopyObject is not supported")
+		}
+		if uploadId != "" {
+			return g.uploadPart(w, r, bucket, key, uploadId)
+		}
+		return g.putObject(w, r, bucket, key)
+	case http.MethodPost:
+		if query.Has("uploads") {
+			return g.createMultipartUpload(w, r, bucket, key)
+		}
+		if uploadId != "" {
+			return g.completeMultipartUpload(w, r, bucket, key, uploadId)
+		}
+	case http.MethodDelete:
+		if uploadId != "" {
+			return g.abortMultipartUpload(w, r, bucket, key, uploadId)
+		}
+		return g.deleteObject(w, r, bucket, key)
+	}
+	return errMethodNotAllowed
+}
+
+// objectFileName the name shared by all the wallet files holding the object, without their version
+func objectFileName(bucket, key string) string {
+	return bucketFilePrefix(bucket) + base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// versionFileName the name of the wallet file holding a version of the object
+func versionFileName(bucket, key string, version int64) string {
+	return objectFileName(bucket, key) + "." + strconv.FormatInt(version, versionBase)
+}
+
+// objectEncryptionTag the encryption tag of the files of the object. It is part of the file hash, so that the files of
+// two objects never share a hash
+func objectEncryptionTag(bucket, key string) string {
+	return objectTagPrefix + crypto.CalcHash([]byte(objectFileName(bucket, key)))[:16]
+}
+
+// bucketFilePrefix the beginning of the names of all the objects of the bucket
+func bucketFilePrefix(bucket string) string {
+	return objectNamePrefix + base64.RawURLEncoding.EncodeToString([]byte(bucket)) + "."
+}
+
+// parseObjectFileName returns the bucket, the key and the version of the object held by the file, or false when the
+// file doesn't hold an object
+func parseObjectFileName(fileName string) (bucket, key string, version int64, ok bool) {
+	if !strings.HasPrefix(fileName, objectNamePrefix) {
+		return "", "", 0, false
+	}
+	parts := strings.Split(strings.TrimPrefix(fileName, objectNamePrefix), ".")
+	if len(parts) != 3 {
+		return "", "", 0, false
+	}
+	bucketBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", "", 0, false
+	}
+	keyBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || len(keyBytes) == 0 {
+		return "", "", 0, false
+	}
+	version, err = strconv.ParseInt(parts[2], versionBase, 64)
+	if err != nil || version <= 0 {
+		return "", "", 0, false
+	}
+	return string(bucketBytes), string(keyBytes), version, true
+}
+
+func stripe(locks *[lockStripes]sync.Mutex, name string) *sync.Mutex {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return &locks[h.Sum32()%lockStripes]
+}
@@ -0,0 +1,229 @@
+package s3gateway
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/file"
+)
+
+const (
+	multipartFolder    = "multipart"
+	uploadInfoFileName = "upload.json"
+	maxPartNumber      = 10000
+	maxCompleteXmlSize = 1024 * 1024
+	// keepAliveInterval while a completed object is uploaded to the network, whitespace is sent to the client so the
+	// connection isn't closed for being idle, as S3 does
+	keepAliveInterval = 10 * time.Second
+)
+
+// multipartUpload an ongoing multipart upload. Its parts are kept in its folder until the upload is completed
+type multipartUpload struct {
+	Bucket    string    `json:"bucket"`
+	Key       string    `json:"key"`
+	Initiated time.Time `json:"initiated"`
+}
+
+func getUploadFolderPath(uploadId string) string {
+	return filepath.Join(file.GetS3GatewayFolderPath(), multipartFolder, uploadId)
+}
+
+func getPartPath(uploadId string, partNumber int) string {
+	return filepath.Join(getUploadFolderPath(uploadId), strconv.Itoa(partNumber)+".part")
+}
+
+func getPartEtagPath(uploadId string, partNumber int) string {
+	return filepath.Join(getUploadFolderPath(uploadId), strconv.Itoa(partNumber)+".etag")
+}
+
+// loadUpload returns the folder of the upload, after checking the upload is for this object
+func loadUpload(bucket, key, uploadId string) (string, error) {
+	if _, err := uuid.Parse(uploadId); err != nil {
+		return "", errNoSuchUpload
+	}
+	folder := getUploadFolderPath(uploadId)
+	data, err := os.ReadFile(filepath.Join(folder, uploadInfoFileName))
+	if err != nil {
+		return "", errNoSuchUpload
+	}
+	upload := &multipartUpload{}
+	if err = json.Unmarshal(data, upload); err != nil || upload.Bucket != bucket || upload.Key != key {
+		return "", errNoSuchUpload
+	}
+	return folder, nil
+}
+
+func (g *Gateway) createMultipartUpload(w http.ResponseWriter, _ *http.Request, bucket, key string) error {
+	uploadId := uuid.New().String()
+	folder := getUploadFolderPath(uploadId)
+	if err := os.MkdirAll(folder, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating dir")
+	}
+	data, err := json.Marshal(&multipartUpload{Bucket: bucket, Key: key, Initiated: time.Now()})
+	if err != nil {
+		return errors.Wrap(err, "failed encoding the multipart upload")
+	}
+	if err = os.WriteFile(filepath.Join(folder, uploadInfoFileName), data, 0600); err != nil {
+		return errors.Wrap(err, "failed writing the multipart upload")
+	}
+	writeXml(w, http.StatusOK, initiateMultipartUploadResult{Bucket: bucket, Key: key, UploadId: uploadId})
+	return nil
+}
+
+func (g *Gateway) uploadPart(w http.ResponseWriter, r *http.Request, bucket, key, uploadId string) error {
+	partNumber, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || partNumber < 1 || partNumber > maxPartNumber {
+		return newApiError(errInvalidArgument, "the part number should be between 1 and "+strconv.Itoa(maxPartNumber))
+	}
+	if _, err = loadUpload(bucket, key, uploadId); err != nil {
+		return err
+	}
+
+	partPath := getPartPath(uploadId, partNumber)
+	hash := md5.New()
+	if err = receiveFile(partPath+".tmp", io.TeeReader(r.Body, hash)); err != nil {
+		return err
+	}
+	if err = os.Rename(partPath+".tmp", partPath); err != nil {
+		return errors.Wrap(err, "failed saving the part")
+	}
+	etag := hex.EncodeToString(hash.Sum(nil))
+	if err = os.WriteFile(getPartEtagPath(uploadId, partNumber), []byte(etag), 0600); err != nil {
+		return errors.Wrap(err, "failed saving the part")
+	}
+	w.Header().Set("ETag", `"`+etag+`"`)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// completeMultipartUpload concatenates the parts, and stores the result as the object. Once the parts are checked, the
+// response status is sent, and an error can only be reported in the body
+func (g *Gateway) completeMultipartUpload(w http.ResponseWriter, r *http.Request, bucket, key, uploadId string) error {
+	folder, err := loadUpload(bucket, key, uploadId)
+	if err != nil {
+		return err
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxCompleteXmlSize))
+	if err != nil {
+		return err
+	}
+	request := &completeMultipartUpload{}
+	if err = xml.Unmarshal(body, request); err != nil || len(request.Parts) == 0 {
+		return errMalformedXML
+	}
+	for i, part := range request.Parts {
+		if i > 0 && part.PartNumber <= request.Parts[i-1].PartNumber {
+			return errInvalidPartOrder
+		}
+		etag, err := os.ReadFile(getPartEtagPath(uploadId, part.PartNumber))
+		if err != nil || strings.Trim(part.ETag, `"`) != string(etag) {
+			return errInvalidPart
+		}
+	}
+
+	workFolder, err := newWorkFolder("complete")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workFolder)
+	path := filepath.Join(workFolder, objectWorkFileName)
+	if err = concatenateParts(path, uploadId, request.Parts); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(xml.Header))
+	o, err := g.storeObjectKeepAlive(r.Context(), w, bucket, key, path)
+	var response interface{}
+	if err != nil {
+		utils.ErrorLogf("s3 gateway: failed completing the multipart upload of %v/%v: %v", bucket, key, err)
+		response = errorResponse{Code: errInternalError.code, Message: err.Error(), Resource: r.URL.Path, RequestId: w.Header().Get("x-amz-request-id")}
+	} else {
+		_ = os.RemoveAll(folder)
+		response = completeMultipartUploadResult{Location: "/" + bucket + "/" + key, Bucket: bucket, Key: key, ETag: o.etag()}
+	}
+	data, _ := xml.Marshal(response)
+	_, _ = w.Write(data)
+	return nil
+}
+
+// storeObjectKeepAlive stores the object, sending whitespace to the client until it is done
+func (g *Gateway) storeObjectKeepAlive(ctx context.Context, w http.ResponseWriter, bucket, key, path string) (*object, error) {
+	type result struct {
+		o   *object
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		o, err := g.storeObject(ctx, bucket, key, path)
+		done <- result{o: o, err: err}
+	}()
+
+	flusher, _ := w.(http.Flusher)
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	for {
+		if flusher != nil {
+			flusher.Flush()
+		}
+		select {
+		case res := <-done:
+			return res.o, res.err
+		case <-ticker.C:
+			_, _ = w.Write([]byte(" "))
+		}
+	}
+}
+
+func concatenateParts(path, uploadId string, parts []completePart) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed creating file")
+	}
+	defer f.Close()
+	for _, part := range parts {
+		if err = appendPart(f, getPartPath(uploadId, part.PartNumber)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendPart(f *os.File, partPath string) error {
+	part, err := os.Open(partPath)
+	if err != nil {
+		return errInvalidPart
+	}
+	defer part.Close()
+	if _, err = io.Copy(f, part); err != nil {
+		return errors.Wrap(err, "failed concatenating the parts")
+	}
+	return nil
+}
+
+func (g *Gateway) abortMultipartUpload(w http.ResponseWriter, _ *http.Request, bucket, key, uploadId string) error {
+	folder, err := loadUpload(bucket, key, uploadId)
+	if err != nil {
+		return err
+	}
+	if err = os.RemoveAll(folder); err != nil {
+		return errors.Wrap(err, "failed deleting the multipart upload")
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
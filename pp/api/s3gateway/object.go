@@ -0,0 +1,405 @@
+package s3gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/api/rpc"
+	"github.com/stratosnet/sds/pp/event"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	defaultMaxKeys = 1000
+	storageClass   = "STANDARD"
+	// objectWorkFileName the name of the local copy of an object. The wallet file is named after the object separately,
+	// since its name can be longer than a local file name
+	objectWorkFileName = "object"
+)
+
+// object an object of a bucket, and the wallet file holding it
+type object struct {
+	key           string
+	fileHash      string
+	encryptionTag string
+	size          uint64
+	version       int64
+	modified      time.Time
+}
+
+func (o *object) etag() string {
+	return `"` + o.fileHash + `"`
+}
+
+// listFiles lists the objects held by the wallet files starting with the name prefix. The files of an object being
+// overwritten are all returned
+func listFiles(ctx context.Context, namePrefix string) (map[string][]*object, error) {
+	files, err := event.ListWalletFiles(ctx, namePrefix)
+	if err != nil {
+		return nil, err
+	}
+	objects := make(map[string][]*object)
+	for _, info := range files {
+		if !strings.HasPrefix(info.FileName, namePrefix) {
+			continue
+		}
+		bucket, key, version, ok := parseObjectFileName(info.FileName)
+		if !ok {
+			continue
+		}
+		name := objectFileName(bucket, key)
+		objects[name] = append(objects[name], newObject(bucket, key, version, info))
+	}
+	return objects, nil
+}
+
+func newObject(bucket, key string, version int64, info rpc.FileInfo) *object {
+	return &object{
+		key:           key,
+		fileHash:      info.FileHash,
+		encryptionTag: objectEncryptionTag(bucket, key),
+		size:          info.FileSize,
+		version:       version,
+		modified:      time.Unix(0, version),
+	}
+}
+
+// latest the most recent of the files of an object
+func latest(files []*object) *object {
+	var result *object
+	for _, o := range files {
+		if result == nil || o.version > result.version {
+			result = o
+		}
+	}
+	return result
+}
+
+// findObject returns the object, and all the files holding it
+func findObject(ctx context.Context, bucket, key string) (*object, []*object, error) {
+	name := objectFileName(bucket, key)
+	objects, err := listFiles(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	files := objects[name]
+	if len(files) == 0 {
+		return nil, nil, errNoSuchKey
+	}
+	return latest(files), files, nil
+}
+
+// listBucketObjects the objects of the bucket, sorted by key
+func listBucketObjects(ctx context.Context, bucket string) ([]*object, error) {
+	objects, err := listFiles(ctx, bucketFilePrefix(bucket))
+	if err != nil {
+		return nil, err
+	}
+	var result []*object
+	for _, files := range objects {
+		result = append(result, latest(files))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].key < result[j].key
+	})
+	return result, nil
+}
+
+func (g *Gateway) listBuckets(w http.ResponseWriter, r *http.Request) error {
+	files, err := event.ListWalletFiles(r.Context(), objectNamePrefix)
+	if err != nil {
+		return err
+	}
+	created := make(map[string]time.Time)
+	for _, info := range files {
+		bucket, _, _, ok := parseObjectFileName(info.FileName)
+		if !ok {
+			continue
+		}
+		createTime := time.Unix(int64(info.CreateTime), 0)
+		if t, found := created[bucket]; !found || createTime.Before(t) {
+			created[bucket] = createTime
+		}
+	}
+	result := listAllMyBucketsResult{Owner: owner{ID: setting.WalletAddress, DisplayName: setting.WalletAddress}}
+	for bucket, t := range created {
+		result.Buckets = append(result.Buckets, bucketInfo{Name: bucket, CreationDate: formatTime(t)})
+	}
+	sort.Slice(result.Buckets, func(i, j int) bool {
+		return result.Buckets[i].Name < result.Buckets[j].Name
+	})
+	writeXml(w, http.StatusOK, result)
+	return nil
+}
+
+func (g *Gateway) deleteBucket(w http.ResponseWriter, r *http.Request, bucket string) error {
+	objects, err := listBucketObjects(r.Context(), bucket)
+	if err != nil {
+		return err
+	}
+	if len(objects) > 0 {
+		return errBucketNotEmpty
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// listObjects implements ListObjectsV2. The continuation token is the last key or common prefix returned
+func (g *Gateway) listObjects(w http.ResponseWriter, r *http.Request, bucket string) error {
+	query := r.URL.Query()
+	result := listBucketResult{
+		Name:              bucket,
+		Prefix:            query.Get("prefix"),
+		Delimiter:         query.Get("delimiter"),
+		StartAfter:        query.Get("start-after"),
+		ContinuationToken: query.Get("continuation-token"),
+		MaxKeys:           defaultMaxKeys,
+	}
+	if maxKeys := query.Get("max-keys"); maxKeys != "" {
+		n, err := strconv.Atoi(maxKeys)
+		if err != nil || n < 0 {
+			return newApiError(errInvalidArgument, "wrong max-keys")
+		}
+		if n < defaultMaxKeys {
+			result.MaxKeys = n
+		}
+	}
+	marker := result.StartAfter
+	if result.ContinuationToken != "" {
+		token, err := base64.RawURLEncoding.DecodeString(result.ContinuationToken)
+		if err != nil {
+			return newApiError(errInvalidArgument, "wrong continuation-token")
+		}
+		marker = string(token)
+	}
+
+	objects, err := listBucketObjects(r.Context(), bucket)
+	if err != nil {
+		return err
+	}
+	last := ""
+	for _, o := range objects {
+		if !strings.HasPrefix(o.key, result.Prefix) || o.key <= marker {
+			continue
+		}
+		entry := o.key
+		if result.Delimiter != "" {
+			if i := strings.Index(o.key[len(result.Prefix):], result.Delimiter); i >= 0 {
+				entry = o.key[:len(result.Prefix)+i+len(result.Delimiter)]
+			}
+		}
+		// the keys grouped in a common prefix already returned are skipped
+		if entry == last || (entry != o.key && entry == marker) {
+			continue
+		}
+		if result.KeyCount == result.MaxKeys {
+			result.IsTruncated = true
+			result.NextContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(last))
+			break
+		}
+		if entry == o.key {
+			result.Contents = append(result.Contents, objectInfo{
+				Key:          o.key,
+				LastModified: formatTime(o.modified),
+				ETag:         o.etag(),
+				Size:         o.size,
+				StorageClass: storageClass,
+			})
+		} else {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: entry})
+		}
+		result.KeyCount++
+		last = entry
+	}
+	writeXml(w, http.StatusOK, result)
+	return nil
+}
+
+func setObjectHeaders(w http.ResponseWriter, o *object) {
+	w.Header().Set("ETag", o.etag())
+	w.Header().Set("Last-Modified", o.modified.UTC().Format(http.TimeFormat))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Accept-Ranges", "bytes")
+}
+
+func (g *Gateway) headObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	o, _, err := findObject(r.Context(), bucket, key)
+	if err != nil {
+		return err
+	}
+	setObjectHeaders(w, o)
+	w.Header().Set("Content-Length", strconv.FormatUint(o.size, 10))
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// getObject downloads the object from the network, and serves it with support for range and conditional requests
+func (g *Gateway) getObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	o, _, err := findObject(r.Context(), bucket, key)
+	if err != nil {
+		return err
+	}
+	folder, err := newWorkFolder("get")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(folder)
+
+	targetPath := filepath.Join(folder, o.fileHash)
+	lock := stripe(&g.downloadLocks, o.fileHash)
+	lock.Lock()
+	err = event.DownloadWalletFile(r.Context(), o.fileHash, o.encryptionTag, targetPath)
+	lock.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "failed downloading the object")
+	}
+
+	f, err := os.Open(targetPath)
+	if err != nil {
+		return errors.Wrap(err, "failed opening the downloaded object")
+	}
+	defer f.Close()
+	setObjectHeaders(w, o)
+	http.ServeContent(w, r, "", o.modified, f)
+	return nil
+}
+
+func (g *Gateway) putObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	folder, err := newWorkFolder("put")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(folder)
+
+	path := filepath.Join(folder, objectWorkFileName)
+	if err = receiveFile(path, r.Body); err != nil {
+		return err
+	}
+	o, err := g.storeObject(r.Context(), bucket, key, path)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", o.etag())
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// storeObject uploads the file as the new version of the object, and deletes the files holding its previous content
+func (g *Gateway) storeObject(ctx context.Context, bucket, key, path string) (*object, error) {
+	encryptionTag := objectEncryptionTag(bucket, key)
+	fileHash, err := crypto.CalcFileHash(path, encryptionTag, crypto.SDS_CODEC)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed calculating the file hash")
+	}
+	info, err := file.GetFileInfo(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := stripe(&g.objectLocks, objectFileName(bucket, key))
+	lock.Lock()
+	defer lock.Unlock()
+	current, previous, err := findObject(ctx, bucket, key)
+	if err != nil && err != errNoSuchKey {
+		return nil, err
+	}
+	if current != nil && current.fileHash == fileHash {
+		if err = deleteFiles(ctx, previous, fileHash); err != nil {
+			utils.ErrorLogf("s3 gateway: failed deleting the previous content of %v/%v: %v", bucket, key, err)
+		}
+		return current, nil
+	}
+
+	// the version orders the files of the object even when they are stored within the same second
+	version := g.now().UnixNano()
+	if current != nil && version <= current.version {
+		version = current.version + 1
+	}
+	fileName := versionFileName(bucket, key, version)
+	if _, err = event.UploadWalletFile(ctx, path, fileName, encryptionTag, g.config.DesiredTier, true); err != nil {
+		return nil, errors.Wrap(err, "failed uploading the object")
+	}
+	if err = deleteFiles(ctx, previous, fileHash); err != nil {
+		utils.ErrorLogf("s3 gateway: failed deleting the previous content of %v/%v: %v", bucket, key, err)
+	}
+	return &object{
+		key:           key,
+		fileHash:      fileHash,
+		encryptionTag: encryptionTag,
+		size:          uint64(info.Size()),
+		version:       version,
+		modified:      time.Unix(0, version),
+	}, nil
+}
+
+// deleteFiles deletes the files of the object, except the one holding the kept file hash. The files of an object are
+// encrypted with its own tag, so no other object shares their hashes
+func deleteFiles(ctx context.Context, files []*object, keptFileHash string) error {
+	deleted := make(map[string]bool)
+	for _, o := range files {
+		fileHash := o.fileHash
+		if fileHash == keptFileHash || deleted[fileHash] {
+			continue
+		}
+		if err := event.DeleteWalletFile(ctx, fileHash); err != nil {
+			return errors.Wrapf(err, "failed deleting %v", fileHash)
+		}
+		deleted[fileHash] = true
+	}
+	return nil
+}
+
+func (g *Gateway) deleteObject(w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	lock := stripe(&g.objectLocks, objectFileName(bucket, key))
+	lock.Lock()
+	defer lock.Unlock()
+	_, files, err := findObject(r.Context(), bucket, key)
+	if err != nil && err != errNoSuchKey {
+		return err
+	}
+	if err = deleteFiles(r.Context(), files, ""); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// newWorkFolder creates a folder for the files of one request
+func newWorkFolder(operation string) (string, error) {
+	id, _ := utils.NextSnowFlakeId()
+	folder := filepath.Join(file.GetS3GatewayFolderPath(), operation, strconv.FormatInt(id, 10))
+	if err := os.MkdirAll(folder, os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "failed creating dir")
+	}
+	return folder, nil
+}
+
+// receiveFile writes the payload of the request to the file
+func receiveFile(path string, body io.Reader) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed creating file")
+	}
+	_, err = io.Copy(f, body)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = errors.Wrap(closeErr, "failed writing file")
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package s3gateway
+
+import (
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const s3TimeFormat = "2006-01-02T15:04:05.000Z"
+
+// apiError an error returned to the client with its S3 error code
+type apiError struct {
+	code    string
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.code + ": " + e.message
+}
+
+func newApiError(err *apiError, message string) *apiError {
+	return &apiError{code: err.code, status: err.status, message: message}
+}
+
+var (
+	errAccessDenied                      = &apiError{"AccessDenied", http.StatusForbidden, "Access Denied"}
+	errAuthorizationHeaderMalformed      = &apiError{"AuthorizationHeaderMalformed", http.StatusBadRequest, "The authorization header is malformed"}
+	errAuthorizationQueryParametersError = &apiError{"AuthorizationQueryParametersError", http.StatusBadRequest, "The authorization query parameters are malformed"}
+	errInvalidAccessKeyId                = &apiError{"InvalidAccessKeyId", http.StatusForbidden, "The access key doesn't exist"}
+	errSignatureDoesNotMatch             = &apiError{"SignatureDoesNotMatch", http.StatusForbidden, "The request signature doesn't match"}
+	errRequestTimeTooSkewed              = &apiError{"RequestTimeTooSkewed", http.StatusForbidden, "The difference between the request time and the server time is too large"}
+	errContentSha256Mismatch             = &apiError{"XAmzContentSHA256Mismatch", http.StatusBadRequest, "The payload doesn't match x-amz-content-sha256"}
+	errIncompleteBody                    = &apiError{"IncompleteBody", http.StatusBadRequest, "The payload is incomplete"}
+	errInvalidArgument                   = &apiError{"InvalidArgument", http.StatusBadRequest, "Invalid argument"}
+	errInvalidBucketName                 = &apiError{"InvalidBucketName", http.StatusBadRequest, "The bucket name is not valid"}
+	errKeyTooLong                        = &apiError{"KeyTooLongError", http.StatusBadRequest, "The key is too long"}
+	errMalformedXML                      = &apiError{"MalformedXML", http.StatusBadRequest, "The XML is not well-formed"}
+	errInvalidPart                       = &apiError{"InvalidPart", http.StatusBadRequest, "A part wasn't uploaded, or its ETag doesn't match"}
+	errInvalidPartOrder                  = &apiError{"InvalidPartOrder", http.StatusBadRequest, "The parts should be listed in ascending order"}
+	errNoSuchKey                         = &apiError{"NoSuchKey", http.StatusNotFound, "The key doesn't exist"}
+	errNoSuchUpload                      = &apiError{"NoSuchUpload", http.StatusNotFound, "The multipart upload doesn't exist"}
+	errBucketNotEmpty                    = &apiError{"BucketNotEmpty", http.StatusConflict, "The bucket isn't empty"}
+	errMethodNotAllowed                  = &apiError{"MethodNotAllowed", http.StatusMethodNotAllowed, "The method is not allowed on this resource"}
+	errNotImplemented                    = &apiError{"NotImplemented", http.StatusNotImplemented, "The operation is not supported by the gateway"}
+	errInternalError                     = &apiError{"InternalError", http.StatusInternalServerError, "Internal error"}
+)
+
+type errorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	Resource  string   `xml:"Resource"`
+	RequestId string   `xml:"RequestId"`
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name     `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListAllMyBucketsResult"`
+	Owner   owner        `xml:"Owner"`
+	Buckets []bucketInfo `xml:"Buckets>Bucket"`
+}
+
+type owner struct {
+	ID          string `xml:"ID"`
+	DisplayName string `xml:"DisplayName"`
+}
+
+type bucketInfo struct {
+	Name         string `xml:"Name"`
+	CreationDate string `xml:"CreationDate"`
+}
+
+type locationConstraint struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LocationConstraint"`
+	Location string   `xml:",chardata"`
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name       `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name                  string         `xml:"Name"`
+	Prefix                string         `xml:"Prefix"`
+	Delimiter             string         `xml:"Delimiter,omitempty"`
+	StartAfter            string         `xml:"StartAfter,omitempty"`
+	ContinuationToken     string         `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string         `xml:"NextContinuationToken,omitempty"`
+	MaxKeys               int            `xml:"MaxKeys"`
+	KeyCount              int            `xml:"KeyCount"`
+	IsTruncated           bool           `xml:"IsTruncated"`
+	Contents              []objectInfo   `xml:"Contents"`
+	CommonPrefixes        []commonPrefix `xml:"CommonPrefixes"`
+}
+
+type objectInfo struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         uint64 `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type commonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ InitiateMultipartUploadResult"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadId string   `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name       `xml:"CompleteMultipartUpload"`
+	Parts   []completePart `xml:"Part"`
+}
+
+type completePart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CompleteMultipartUploadResult"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(s3TimeFormat)
+}
+
+func writeXml(w http.ResponseWriter, status int, response interface{}) {
+	data, err := xml.Marshal(response)
+	if err != nil {
+		utils.ErrorLog("failed encoding s3 response", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
+
+// writeError writes the S3 error matching err. Errors which aren't S3 errors are returned as internal errors
+func writeError(w http.ResponseWriter, r *http.Request, requestId string, err error) {
+	apiErr := &apiError{}
+	if !errors.As(err, &apiErr) {
+		utils.ErrorLogf("s3 gateway: %v %v failed: %v", r.Method, r.URL.Path, err)
+		apiErr = newApiError(errInternalError, err.Error())
+	}
+	if r.Method == http.MethodHead {
+		w.WriteHeader(apiErr.status)
+		return
+	}
+	writeXml(w, apiErr.status, errorResponse{
+		Code:      apiErr.code,
+		Message:   apiErr.message,
+		Resource:  r.URL.Path,
+		RequestId: requestId,
+	})
+}
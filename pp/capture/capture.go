@@ -0,0 +1,144 @@
+package capture
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const queueSize = 1024
+
+// Record a message captured on a conn, written as one JSON line. The body is kept raw so an inbound message can be
+// replayed, and decoded in Message to be read
+type Record struct {
+	Time      time.Time       `json:"time"`
+	Inbound   bool            `json:"inbound"`
+	Peer      string          `json:"peer"` // P2P address of the remote node
+	Cmd       uint8           `json:"cmd"`
+	Type      string          `json:"type"`
+	Version   uint16          `json:"version"`
+	ReqId     int64           `json:"req_id"`
+	PacketId  int64           `json:"packet_id,omitempty"`
+	Signer    string          `json:"signer,omitempty"`
+	PubKey    []byte          `json:"pub_key,omitempty"`
+	Signature []byte          `json:"signature,omitempty"`
+	Body      []byte          `json:"body"`
+	Message   json.RawMessage `json:"message,omitempty"`
+	DataLen   int             `json:"data_len,omitempty"`
+	Data      []byte          `json:"data,omitempty"`
+}
+
+type capturer struct {
+	records     chan *Record
+	stop        chan struct{}
+	stopped     chan struct{}
+	includeData bool
+	file        *rotatingFile
+	dropped     atomic.Int64
+}
+
+var current atomic.Pointer[capturer]
+
+// Start records the messages of the conns in the capture file, when the capture is enabled
+func Start() error {
+	config := setting.Config.Capture
+	if !config.Enabled {
+		return nil
+	}
+	file, err := openRotatingFile(config.Path, int64(config.MaxFileSize)*1024*1024, config.MaxFiles)
+	if err != nil {
+		return err
+	}
+	c := &capturer{
+		records:     make(chan *Record, queueSize),
+		stop:        make(chan struct{}),
+		stopped:     make(chan struct{}),
+		includeData: config.IncludeData,
+		file:        file,
+	}
+	go c.writeLoop()
+	current.Store(c)
+	utils.Log("capturing the messages in", config.Path)
+	return nil
+}
+
+// Stop writes the messages captured so far, and closes the capture file
+func Stop() {
+	c := current.Swap(nil)
+	if c == nil {
+		return
+	}
+	close(c.stop)
+	<-c.stopped
+}
+
+// Message records a message written or read by a conn. The buffers of the message are reused once it's handled, so
+// they are copied before being queued to the capture file. A message is dropped when the file can't keep up
+func Message(inbound bool, remoteP2pAddress string, m *msg.RelayMsgBuf) {
+	c := current.Load()
+	if c == nil {
+		return
+	}
+	record := &Record{
+		Time:      time.Now(),
+		Inbound:   inbound,
+		Peer:      remoteP2pAddress,
+		Cmd:       m.MSGHead.Cmd,
+		Version:   m.MSGHead.Version,
+		ReqId:     m.MSGHead.ReqId,
+		PacketId:  m.PacketId,
+		Signer:    m.MSGSign.P2pAddress,
+		PubKey:    bytes.Clone(m.MSGSign.P2pPubKey),
+		Signature: bytes.Clone(m.MSGSign.Signature),
+		Body:      bytes.Clone(m.MSGBody),
+		DataLen:   len(m.MSGData),
+	}
+	if c.includeData {
+		record.Data = bytes.Clone(m.MSGData)
+	}
+	select {
+	case c.records <- record:
+	default:
+		c.dropped.Add(1)
+	}
+}
+
+func (c *capturer) writeLoop() {
+	defer close(c.stopped)
+	for {
+		select {
+		case record := <-c.records:
+			c.write(record)
+		case <-c.stop:
+			for len(c.records) > 0 {
+				c.write(<-c.records)
+			}
+			if dropped := c.dropped.Load(); dropped > 0 {
+				utils.Logf("%v messages weren't captured, the capture file couldn't keep up", dropped)
+			}
+			_ = c.file.Close()
+			return
+		}
+	}
+}
+
+func (c *capturer) write(record *Record) {
+	if msgType := header.GetMsgTypeFromId(record.Cmd); msgType != nil {
+		record.Type = msgType.Name
+	}
+	record.Message, _ = DecodeBody(record.Cmd, record.Body)
+	line, err := json.Marshal(record)
+	if err != nil {
+		utils.ErrorLog("failed encoding a captured message", err)
+		return
+	}
+	if err = c.file.Write(append(line, '\n')); err != nil {
+		utils.ErrorLog("failed writing the capture file", err)
+	}
+}
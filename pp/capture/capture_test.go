@@ -0,0 +1,97 @@
+package capture
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestCaptureAndReplay(t *testing.T) {
+	utils.NewDefaultLogger("", false, false)
+	setting.Config = setting.DefaultConfig()
+	setting.Config.Capture.Enabled = true
+	setting.Config.Capture.Path = filepath.Join(t.TempDir(), "capture.jsonl")
+	if err := Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := proto.Marshal(&protos.ReqGetPPStatus{InitPpList: true})
+	m := &msg.RelayMsgBuf{
+		MSGHead: header.MakeMessageHeader(1, 12, uint32(len(body)), header.ReqGetPPStatus),
+		MSGBody: body,
+		MSGData: []byte("slice data"),
+	}
+	m.MSGHead.ReqId = 42
+	Message(false, "stsds1local", m)
+	Message(true, "stsds1remote", m)
+	Stop()
+
+	var records []*Record
+	err := ReadFile(setting.Config.Capture.Path, func(index int, record *Record) error {
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].Inbound || !records[1].Inbound || records[1].Peer != "stsds1remote" {
+		t.Fatal("wrong records", records)
+	}
+	record := records[1]
+	if record.Type != header.ReqGetPPStatus.Name || record.ReqId != 42 || record.DataLen != len(m.MSGData) ||
+		record.Data != nil || !strings.Contains(string(record.Message), "initPpList") {
+		t.Fatal("wrong record", record)
+	}
+
+	// the data wasn't captured
+	if err = Replay(context.Background(), record, &ReplayConn{}); err == nil {
+		t.Fatal("a message without its data must not be replayed")
+	}
+	record.DataLen = 0
+	var replayed *msg.RelayMsgBuf
+	core.Register(header.ReqGetPPStatus, func(ctx context.Context, conn core.WriteCloser) {
+		replayed = core.MessageFromContext(ctx)
+		_ = conn.Write(replayed, ctx)
+	})
+	var answered bool
+	if err = Replay(context.Background(), record, &ReplayConn{OnWrite: func(*msg.RelayMsgBuf) { answered = true }}); err != nil {
+		t.Fatal(err)
+	}
+	if replayed == nil || replayed.MSGHead.ReqId != 42 || string(replayed.MSGBody) != string(body) || !answered {
+		t.Fatal("wrong replayed message", replayed)
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "capture.jsonl")
+	f, err := openRotatingFile(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if err = f.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = f.Close()
+
+	for path, expected := range map[string]string{path: "fourth\n", path + ".1": "third\n", path + ".2": "second\n"} {
+		if content, _ := os.ReadFile(path); string(content) != expected {
+			t.Fatal("wrong content of", path, string(content))
+		}
+	}
+	if _, err = os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Fatal("only 2 rotated files must be kept")
+	}
+}
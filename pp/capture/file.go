@@ -0,0 +1,82 @@
+package capture
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// rotatingFile the capture file. When it reaches maxSize, it is renamed path.1, the previous path.1 becomes path.2,
+// and so on until path.maxFiles, which is removed
+type rotatingFile struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+	file     *os.File
+	size     int64
+}
+
+func openRotatingFile(path string, maxSize int64, maxFiles int) (*rotatingFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "failed creating the capture folder")
+	}
+	f := &rotatingFile{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening the capture file")
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "failed opening the capture file")
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) Write(line []byte) error {
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(line)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := f.file.Write(line)
+	f.size += int64(n)
+	return err
+}
+
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	_ = os.Remove(rotatedPath(f.path, f.maxFiles))
+	for i := f.maxFiles - 1; i > 0; i-- {
+		_ = os.Rename(rotatedPath(f.path, i), rotatedPath(f.path, i+1))
+	}
+	if f.maxFiles > 0 {
+		if err := os.Rename(f.path, rotatedPath(f.path, 1)); err != nil {
+			return errors.Wrap(err, "failed rotating the capture file")
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return errors.Wrap(err, "failed rotating the capture file")
+	}
+	return f.open()
+}
+
+func (f *rotatingFile) Close() error {
+	return f.file.Close()
+}
+
+func rotatedPath(path string, index int) string {
+	return path + "." + strconv.Itoa(index)
+}
@@ -0,0 +1,117 @@
+package capture
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/msg"
+	"github.com/stratosnet/sds/framework/msg/header"
+)
+
+// ReadFile calls fn with each record of a capture file, and its index in the file
+func ReadFile(path string, fn func(index int, record *Record) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed opening the capture file")
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	for index := 0; ; index++ {
+		record := &Record{}
+		if err = decoder.Decode(record); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrapf(err, "invalid record %v", index)
+		}
+		if err = fn(index, record); err != nil {
+			return err
+		}
+	}
+}
+
+// RelayMsgBuf the message of the record, as it was received
+func (r *Record) RelayMsgBuf() *msg.RelayMsgBuf {
+	return &msg.RelayMsgBuf{
+		PacketId: r.PacketId,
+		MSGHead: header.MessageHead{
+			Tag:     1,
+			Len:     uint32(len(r.Body)),
+			DataLen: uint32(len(r.Data)),
+			Cmd:     r.Cmd,
+			ReqId:   r.ReqId,
+			Version: r.Version,
+		},
+		MSGSign: msg.MessageSign{
+			Signature:  r.Signature,
+			P2pAddress: r.Signer,
+			P2pPubKey:  r.PubKey,
+		},
+		MSGBody: r.Body,
+		MSGData: r.Data,
+	}
+}
+
+// Replay passes the inbound message of the record to the handler registered for its command with core.Register, as if
+// it was received on conn
+func Replay(ctx context.Context, record *Record, conn core.WriteCloser) (err error) {
+	if !record.Inbound {
+		return errors.New("only the inbound messages can be replayed")
+	}
+	handler := core.GetHandlerFunc(record.Cmd)
+	if handler == nil {
+		return errors.Errorf("no handler registered for the messages of type %v", record.Cmd)
+	}
+	if record.DataLen > 0 && len(record.Data) != record.DataLen {
+		return errors.New("the data of the message wasn't captured")
+	}
+	m := record.RelayMsgBuf()
+	ctx = core.CreateContextWithReqId(ctx, m.MSGHead.ReqId)
+	ctx = core.CreateContextWithRecvStartTime(ctx, time.Now().UnixMilli())
+	ctx = core.CreateContextWithMessage(ctx, m)
+	ctx = core.CreateContextWithSrcP2pAddr(ctx, record.Peer)
+
+	// the node isn't started, a handler relying on a part of it which wasn't initialized fails
+	defer func() {
+		if p := recover(); p != nil {
+			err = errors.Errorf("the handler panicked: %v", p)
+		}
+	}()
+	handler(ctx, conn)
+	return nil
+}
+
+// ReplayConn the conn of the replayed messages. The messages written by the handlers are passed to OnWrite instead
+// of being sent
+type ReplayConn struct {
+	OnWrite func(m *msg.RelayMsgBuf)
+}
+
+func (c *ReplayConn) Write(m *msg.RelayMsgBuf, _ context.Context) error {
+	if c.OnWrite != nil {
+		c.OnWrite(m)
+	}
+	return nil
+}
+
+func (c *ReplayConn) Close() {}
+
+// DecodeBody the body of the message as JSON, or nil when its type is unknown
+func DecodeBody(cmd uint8, body []byte) ([]byte, error) {
+	message := NewMessage(cmd)
+	if message == nil {
+		return nil, nil
+	}
+	if err := proto.Unmarshal(body, message); err != nil {
+		return nil, errors.Wrap(err, "invalid message body")
+	}
+	return protojson.Marshal(message)
+}
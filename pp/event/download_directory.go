@@ -0,0 +1,216 @@
+package event
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/crypto"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/requests"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/pp/task"
+)
+
+// time allowed without any progress before a download is considered failed
+const downloadStallTimeout = 5 * time.Minute
+
+// DirectoryDownload is the result of the restoration of a directory from its manifest
+type DirectoryDownload struct {
+	Path   string            // local path of the restored directory
+	Files  map[string]string // relative path -> local path
+	Failed map[string]string // relative path -> error message
+}
+
+// RequestDownloadDirectory downloads the manifest of an uploaded directory, then recreates the directory tree under
+// the download folder, with at most concurrency files being downloaded at the same time. Files already restored are
+// skipped, and files partially downloaded before resume from the slices recorded in their download csv
+func RequestDownloadDirectory(ctx context.Context, manifestHandle string, concurrency int) (*DirectoryDownload, error) {
+	if !setting.CheckLogin() {
+		return nil, errors.New("please login first")
+	}
+	_, ownerWalletAddress, manifestHash, _, err := fwtypes.ParseFileHandle(manifestHandle)
+	if err != nil {
+		return nil, errors.New("wrong file path format, failed to parse")
+	}
+	if ownerWalletAddress != setting.WalletAddress {
+		return nil, errors.New("only the file owner is allowed to download via sdm url")
+	}
+	if concurrency <= 0 {
+		concurrency = DEFAULT_DIRECTORY_UPLOAD_CONCURRENCY
+	}
+	if concurrency > MAX_DIRECTORY_UPLOAD_CONCURRENCY {
+		concurrency = MAX_DIRECTORY_UPLOAD_CONCURRENCY
+	}
+
+	manifestId, _ := utils.NextSnowFlakeId()
+	manifestPath := filepath.Join(file.GetManifestFolderPath(), strconv.FormatInt(manifestId, 10), manifestHash)
+	if err = downloadFileAndWait(ctx, manifestHandle, manifestHash, "", manifestPath); err != nil {
+		return nil, errors.Wrap(err, "failed downloading the manifest")
+	}
+	manifest, err := file.LoadDirectoryManifest(manifestPath)
+	_ = os.RemoveAll(filepath.Dir(manifestPath))
+	if err != nil {
+		return nil, errors.Wrap(err, "the file is not a directory manifest")
+	}
+
+	result := &DirectoryDownload{
+		Path:   filepath.Join(setting.Config.Home.DownloadPath, manifest.Name),
+		Files:  make(map[string]string),
+		Failed: make(map[string]string),
+	}
+	pp.Logf(ctx, "restoring %v files of directory %v to %v", len(manifest.Files), manifest.Name, result.Path)
+
+	// a file is downloaded only once, even when several paths of the directory have the same content
+	pathsByHandle := make(map[string][]string)
+	for relPath, handle := range manifest.Files {
+		pathsByHandle[handle] = append(pathsByHandle[handle], relPath)
+	}
+	var handles []string
+	for handle, relPaths := range pathsByHandle {
+		sort.Strings(relPaths)
+		handles = append(handles, handle)
+	}
+	sort.Strings(handles)
+
+	var (
+		resultMutex sync.Mutex
+		wg          sync.WaitGroup
+		sem         = make(chan struct{}, concurrency)
+	)
+	restoreOne := func(handle string, relPaths []string) {
+		defer wg.Done()
+		defer func() { <-sem }()
+
+		err := restoreFile(ctx, handle, manifest.EncryptionTags[handle], result.Path, relPaths)
+		resultMutex.Lock()
+		defer resultMutex.Unlock()
+		for _, relPath := range relPaths {
+			if err != nil {
+				pp.ErrorLogf(ctx, "failed restoring %v: %v", relPath, err)
+				result.Failed[relPath] = err.Error()
+				continue
+			}
+			result.Files[relPath] = filepath.Join(result.Path, filepath.FromSlash(relPath))
+			pp.Logf(ctx, "restored %v (%v/%v)", relPath, len(result.Files), len(manifest.Files))
+		}
+	}
+	for _, handle := range handles {
+		sem <- struct{}{}
+		wg.Add(1)
+		go restoreOne(handle, pathsByHandle[handle])
+	}
+	wg.Wait()
+
+	if len(result.Failed) > 0 {
+		return result, errors.Errorf("%v files failed to download, run the same command again to resume", len(result.Failed))
+	}
+	pp.Logf(ctx, "directory %v restored to %v", manifest.Name, result.Path)
+	return result, nil
+}
+
+// restoreFile downloads the file to the first path, and copies it to the other paths
+func restoreFile(ctx context.Context, handle, encryptionTag, rootPath string, relPaths []string) error {
+	_, _, fileHash, _, err := fwtypes.ParseFileHandle(handle)
+	if err != nil {
+		return err
+	}
+	targetPaths := make([]string, len(relPaths))
+	for i, relPath := range relPaths {
+		targetPaths[i] = filepath.Join(rootPath, filepath.FromSlash(relPath))
+	}
+
+	source := ""
+	for _, targetPath := range targetPaths {
+		if isFileRestored(targetPath, fileHash, encryptionTag) {
+			source = targetPath
+			break
+		}
+	}
+	if source == "" {
+		source = targetPaths[0]
+		if err = downloadFileAndWait(ctx, handle, fileHash, encryptionTag, source); err != nil {
+			return err
+		}
+	}
+
+	for _, targetPath := range targetPaths {
+		if targetPath == source || isFileRestored(targetPath, fileHash, encryptionTag) {
+			continue
+		}
+		if err = file.CopyFile(source, targetPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isFileRestored whether the file at the path has the content of the file hash. The hash of an encrypted file depends on
+// its encryption tag, which is given by its file info
+func isFileRestored(filePath, fileHash, encryptionTag string) bool {
+	if exist, err := file.PathExists(filePath); err != nil || !exist {
+		return false
+	}
+	hash, err := crypto.CalcFileHash(filePath, encryptionTag, crypto.SDS_CODEC)
+	return err == nil && hash == fileHash
+}
+
+// downloadFileAndWait downloads a file of the node wallet to the target path, and blocks until the download is over
+func downloadFileAndWait(ctx context.Context, handle, fileHash, encryptionTag, targetPath string) error {
+	if task.CheckDownloadTask(fileHash, setting.WalletAddress, task.LOCAL_REQID) {
+		return errors.New("this file is being downloaded by another task")
+	}
+	reqId, _ := utils.NextSnowFlakeId()
+	core.InheritRpcLoggerFromParentReqId(ctx, reqId)
+	fileCtx := core.CreateContextWithReqId(ctx, reqId)
+	core.RegisterReqId(fileCtx, task.LOCAL_REQID)
+
+	file.SetDownloadTargetPath(fileHash, targetPath)
+	defer file.DeleteDownloadTargetPath(fileHash)
+	resultKey := fileHash + strconv.FormatInt(reqId, 10)
+	done := task.SubscribeDownloadResult(resultKey)
+	defer task.UnsubscribeDownloadResult(resultKey)
+
+	req := requests.ReqFileStorageInfoData(fileCtx, handle, "", "", setting.WalletAddress, setting.WalletPublicKey.Bytes(), nil, nil, time.Now().Unix())
+	if err := ReqGetWalletOzForDownload(fileCtx, setting.WalletAddress, task.LOCAL_REQID, req); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+	lastProgress := time.Now()
+	var downloadedSize int64
+	for {
+		select {
+		case success := <-done:
+			// the download fails when the target already exists, which is fine as long as it has the right content
+			if isFileRestored(targetPath, fileHash, encryptionTag) {
+				return nil
+			}
+			if success {
+				return errors.New("the downloaded file could not be saved")
+			}
+			return errors.New("the download failed")
+		case <-ticker.C:
+			if value, ok := task.DownloadSpeedOfProgress.Load(fileHash + task.LOCAL_REQID); ok {
+				if size := value.(*task.DownloadSP).DownloadedSize; size != downloadedSize {
+					downloadedSize = size
+					lastProgress = time.Now()
+				}
+			}
+			if time.Since(lastProgress) > downloadStallTimeout {
+				return errors.New("timeout, the download is not making progress")
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package event
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/pp/file"
+)
+
+func TestIsFileRestoredEncrypted(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "restored")
+	if err := os.WriteFile(filePath, []byte("restored content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	encryptionTag := file.CONTENT_KEY_TAG_PREFIX + "abcdefgh"
+	fileHash, err := crypto.CalcFileHash(filePath, encryptionTag, crypto.SDS_CODEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !isFileRestored(filePath, fileHash, encryptionTag) {
+		t.Fatal("an encrypted file with the right content should be restored")
+	}
+	if isFileRestored(filePath, fileHash, "") {
+		t.Fatal("the hash of an encrypted file can't match without its encryption tag")
+	}
+	if isFileRestored(filePath+".missing", fileHash, encryptionTag) {
+		t.Fatal("a missing file isn't restored")
+	}
+	if err = os.WriteFile(filePath, []byte("other content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if isFileRestored(filePath, fileHash, encryptionTag) {
+		t.Fatal("a file with another content isn't restored")
+	}
+}
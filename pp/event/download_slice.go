@@ -9,11 +9,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/stratosnet/sds/framework/client/cf"
 	"github.com/stratosnet/sds/framework/core"
-	"github.com/stratosnet/sds/framework/crypto"
 	"github.com/stratosnet/sds/framework/crypto/encryption"
 	"github.com/stratosnet/sds/framework/crypto/encryption/hdkey"
 	"github.com/stratosnet/sds/framework/msg/header"
@@ -25,6 +25,7 @@ import (
 	"github.com/stratosnet/sds/pp/requests"
 	"github.com/stratosnet/sds/pp/setting"
 	"github.com/stratosnet/sds/pp/task"
+	"github.com/stratosnet/sds/pp/tracing"
 	"github.com/stratosnet/sds/sds-msg/protos"
 )
 
@@ -217,6 +218,9 @@ func splitSendDownloadSliceData(ctx context.Context, target *protos.ReqDownloadS
 		}
 	}
 	sliceTaskId := slice.TaskId
+	ctx, span := tracing.StartSlice(ctx, "serve slice download", target.RspFileStorageInfo.FileHash,
+		slice.SliceStorageInfo.SliceHash, target.P2PAddress)
+	defer span.End()
 
 	rsp, data = requests.RspDownloadSliceData(ctx, target, slice)
 	if rsp == nil && data == nil {
@@ -436,7 +440,7 @@ func receiveSliceAndProgressEncrypted(ctx context.Context, target *protos.RspDow
 
 	if dataToDecryptSize >= target.SliceSize {
 		// Decrypt slice data and save it to file
-		decryptedData, err := decryptSliceData(dataToDecrypt)
+		decryptedData, err := decryptSliceData(dataToDecrypt, fInfo)
 		if err != nil {
 			pp.ErrorLog(ctx, "Couldn't decrypt slice", err)
 			return
@@ -555,10 +559,15 @@ func SendReqDownloadSlice(ctx context.Context, fileHash string, sliceInfo *proto
 	utils.DebugLog("req = ", req)
 
 	networkAddress := sliceInfo.StoragePpInfo.NetworkAddress
+	p2pserver.GetP2pServer(ctx).StorePpAddresses(sliceInfo.StoragePpInfo)
 	key := "download#" + fileHash + sliceInfo.StoragePpInfo.P2PAddress + fileReqId
+	ctx, span := tracing.StartSlice(ctx, "download slice", fileHash, sliceInfo.SliceStorageInfo.SliceHash,
+		sliceInfo.StoragePpInfo.P2PAddress)
+	defer span.End()
 	metrics.UploadPerformanceLogNow(fileHash + ":SND_REQ_SLICE_DATA:" + strconv.FormatInt(int64(sliceInfo.SliceOffset.SliceOffsetStart+(req.SliceNumber-1)*setting.MaxSliceSize), 10) + ":" + networkAddress)
 	err := p2pserver.GetP2pServer(ctx).SendMessageByCachedConn(ctx, key, networkAddress, req, header.ReqDownloadSlice, nil)
 	if err != nil {
+		span.RecordError(err)
 		pp.ErrorLogf(ctx, "Failed to create connection with %v: %v", networkAddress, utils.FormatError(err))
 		if dTask, ok := task.GetDownloadTask(fileHash + req.RspFileStorageInfo.WalletAddress + fileReqId); ok {
 			setDownloadSliceFail(ctx, sliceInfo.SliceStorageInfo.SliceHash, req.RspFileStorageInfo.TaskId, fileReqId, dTask)
@@ -595,7 +604,11 @@ func DownloadSliceCancel(ctx context.Context, fileHash, reqID string) {
 	}
 }
 
-func decryptSliceData(dataToDecrypt []byte) ([]byte, error) {
+func decryptSliceData(dataToDecrypt []byte, fInfo *protos.RspFileStorageInfo) ([]byte, error) {
+	if file.IsContentKeyEncrypted(fInfo.EncryptionTag) {
+		return decryptSliceDataWithContentKey(dataToDecrypt, fInfo.FileHash)
+	}
+
 	encryptedSlice := protos.EncryptedSlice{}
 	err := proto.Unmarshal(dataToDecrypt, &encryptedSlice)
 	if err != nil {
@@ -612,12 +625,34 @@ func decryptSliceData(dataToDecrypt []byte) ([]byte, error) {
 	return encryption.DecryptAES(key.PrivateKey(), encryptedSlice.Data, encryptedSlice.AesNonce, false)
 }
 
+func decryptSliceDataWithContentKey(dataToDecrypt []byte, fileHash string) ([]byte, error) {
+	encryptedSlice := protos.ChunkEncryptedSlice{}
+	err := proto.Unmarshal(dataToDecrypt, &encryptedSlice)
+	if err != nil {
+		utils.ErrorLog("Couldn't unmarshal protobuf to chunk encrypted slice", err)
+		return nil, err
+	}
+
+	contentKey, err := file.GetContentKey(fileHash)
+	if err != nil {
+		return nil, err
+	}
+	data, err := encryption.DecryptChunks(contentKey, encryptedSlice.Salt, encryptedSlice.Data, int(encryptedSlice.ChunkSize))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) != encryptedSlice.RawSize {
+		return nil, errors.New("wrong size of the decrypted slice")
+	}
+	return data, nil
+}
+
 func verifyDownloadSliceHash(fileHash string, sliceNumber uint64, slice *protos.DownloadSliceInfo, buffers [][]byte) bool {
 	var data []byte
 	for _, buffer := range buffers {
 		data = append(data, buffer...)
 	}
-	sliceHash, err := crypto.CalcSliceHash(data, fileHash, sliceNumber)
+	sliceHash, err := file.CalcSliceHashLike(slice.SliceStorageInfo.SliceHash, data, fileHash, sliceNumber)
 	if err != nil {
 		utils.ErrorLog(err)
 		return false
@@ -51,6 +51,7 @@ func RegisterAllEventHandlers() {
 	registerEvent(header.RspReportDownloadResult, RspReportDownloadResult, SpRspVerifier)
 	registerEvent(header.RspUploadSlicesWrong, RspUploadSlicesWrong, RspUploadFileWithNoReqIdVerifier)
 	registerEvent(header.RspReportBackupSliceResult, RspReportBackupSliceResult, SpRspVerifier)
+	registerEvent(header.RspReportCorruptedSlices, RspReportCorruptedSlices, SpRspVerifier)
 	registerEvent(header.RspFileBackupStatus, RspBackupStatus, RspBackupStatusVerifier)
 	registerEvent(header.RspFileStorageInfo, RspFileStorageInfo, RspFileStorageInfoVerifier)
 	registerEvent(header.RspFileReplicaInfo, RspFileReplicaInfo, SpRspVerifier)
@@ -0,0 +1,234 @@
+package event
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/api/rpc"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+// objectVersionMutex serializes the changes of the versioned objects made by this node
+var objectVersionMutex sync.Mutex
+
+// GetObjectVersions the version history of an object of the node wallet, read from its latest manifest in the network
+func GetObjectVersions(ctx context.Context, key string) (*file.VersionManifest, error) {
+	if err := file.ValidateObjectKey(key); err != nil {
+		return nil, err
+	}
+	manifest, err := loadLatestVersionManifest(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		return nil, errors.Errorf("object %v has no version", key)
+	}
+	return manifest, nil
+}
+
+// PutObjectVersion uploads the file as the new latest version of the object
+func PutObjectVersion(ctx context.Context, key, filePath string, desiredTier uint32, allowHigherTier bool) (*file.ObjectVersion, error) {
+	if !setting.CheckLogin() {
+		return nil, errors.New("please login first")
+	}
+	if err := file.ValidateObjectKey(key); err != nil {
+		return nil, err
+	}
+	info, err := file.GetFileInfo(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, errors.New("the provided path indicates a directory, not a file")
+	}
+
+	objectVersionMutex.Lock()
+	defer objectVersionMutex.Unlock()
+	manifest, err := loadLatestVersionManifest(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		manifest = &file.VersionManifest{Version: file.VERSION_MANIFEST_VERSION, Key: key}
+	}
+
+	handle, err := uploadFileAndWait(ctx, filePath, "", "", nil, desiredTier, allowHigherTier, nil)
+	if err != nil {
+		return nil, err
+	}
+	version := file.ObjectVersion{
+		Number:     nextVersionNumber(manifest),
+		FileHandle: handle,
+		FileName:   info.Name(),
+		FileSize:   uint64(info.Size()),
+		Timestamp:  time.Now().Unix(),
+	}
+	return appendObjectVersion(ctx, manifest, version, desiredTier, allowHigherTier)
+}
+
+// RollbackObjectVersion makes a previous version the latest one again. The rollback is added to the history as a new
+// version, so the versions after the restored one are kept
+func RollbackObjectVersion(ctx context.Context, key string, number uint64, desiredTier uint32, allowHigherTier bool) (*file.ObjectVersion, error) {
+	if !setting.CheckLogin() {
+		return nil, errors.New("please login first")
+	}
+	if number == 0 {
+		return nil, errors.New("the version to roll back to should be specified")
+	}
+
+	objectVersionMutex.Lock()
+	defer objectVersionMutex.Unlock()
+	manifest, err := GetObjectVersions(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	restored, err := manifest.GetVersion(number)
+	if err != nil {
+		return nil, err
+	}
+	version := *restored
+	version.Number = nextVersionNumber(manifest)
+	version.Timestamp = time.Now().Unix()
+	version.RollbackOf = restored.Number
+	return appendObjectVersion(ctx, manifest, version, desiredTier, allowHigherTier)
+}
+
+// DownloadObjectVersion downloads a version of the object to the download folder, the latest one when number is 0, and
+// returns the path of the downloaded file
+func DownloadObjectVersion(ctx context.Context, key string, number uint64) (string, error) {
+	if !setting.CheckLogin() {
+		return "", errors.New("please login first")
+	}
+	manifest, err := GetObjectVersions(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	version, err := manifest.GetVersion(number)
+	if err != nil {
+		return "", err
+	}
+	_, _, fileHash, _, err := fwtypes.ParseFileHandle(version.FileHandle)
+	if err != nil {
+		return "", err
+	}
+	targetPath := filepath.Join(setting.Config.Home.DownloadPath, version.FileName+".v"+strconv.FormatUint(version.Number, 10))
+	if isFileRestored(targetPath, fileHash, version.EncryptionTag) {
+		return targetPath, nil
+	}
+	if err = downloadFileAndWait(ctx, version.FileHandle, fileHash, version.EncryptionTag, targetPath); err != nil {
+		return "", err
+	}
+	return targetPath, nil
+}
+
+func nextVersionNumber(manifest *file.VersionManifest) uint64 {
+	if latest := manifest.Latest(); latest != nil {
+		return latest.Number + 1
+	}
+	return 1
+}
+
+// appendObjectVersion adds the version to the history, and uploads the new manifest signed by the node wallet
+func appendObjectVersion(ctx context.Context, manifest *file.VersionManifest, version file.ObjectVersion, desiredTier uint32,
+	allowHigherTier bool) (*file.ObjectVersion, error) {
+	manifest.Versions = append(manifest.Versions, version)
+	manifest.Sequence++
+	if err := manifest.Sign(); err != nil {
+		return nil, err
+	}
+	manifestId, _ := utils.NextSnowFlakeId()
+	manifestPath, err := file.SaveVersionManifest(manifest, strconv.FormatInt(manifestId, 10))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.RemoveAll(filepath.Dir(manifestPath))
+	}()
+	manifestHandle, err := uploadFileAndWait(ctx, manifestPath, "", "", nil, desiredTier, allowHigherTier, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed uploading the version manifest")
+	}
+	pp.Logf(ctx, "version %v of object %v saved, manifest: %v", version.Number, manifest.Key, manifestHandle)
+	deleteSupersededManifests(ctx, manifest.Key, manifest.Sequence)
+	return &version, nil
+}
+
+// deleteSupersededManifests deletes the manifests of the object older than the sequence. They only hold a prefix of the
+// history of the latest manifest. A failure is logged, the manifests left are deleted with the next version
+func deleteSupersededManifests(ctx context.Context, key string, sequence uint64) {
+	files, err := ListWalletFiles(ctx, file.VersionManifestPrefix(key))
+	if err != nil {
+		pp.ErrorLogf(ctx, "failed listing the superseded version manifests of object %v: %v", key, err)
+		return
+	}
+	for _, info := range files {
+		if s, ok := file.ParseVersionManifestName(key, info.FileName); !ok || s >= sequence {
+			continue
+		}
+		if err = DeleteWalletFile(ctx, info.FileHash); err != nil {
+			pp.ErrorLogf(ctx, "failed deleting the superseded version manifest %v: %v", info.FileName, err)
+		}
+	}
+}
+
+// loadLatestVersionManifest finds the manifest of the object with the highest sequence among the files of the wallet.
+// A manifest which can't be downloaded or verified is skipped, and an error is returned when none of them can be
+// loaded. Nil is returned when the object has no manifest
+func loadLatestVersionManifest(ctx context.Context, key string) (*file.VersionManifest, error) {
+	files, err := ListWalletFiles(ctx, file.VersionManifestPrefix(key))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed listing the version manifests")
+	}
+	type candidate struct {
+		sequence uint64
+		info     rpc.FileInfo
+	}
+	var candidates []candidate
+	for _, info := range files {
+		if sequence, ok := file.ParseVersionManifestName(key, info.FileName); ok {
+			candidates = append(candidates, candidate{sequence: sequence, info: info})
+		}
+	}
+	// when two nodes wrote the same sequence, the last manifest wins
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].sequence != candidates[j].sequence {
+			return candidates[i].sequence > candidates[j].sequence
+		}
+		return candidates[i].info.CreateTime > candidates[j].info.CreateTime
+	})
+
+	for _, c := range candidates {
+		handle := fwtypes.DataMeshId{Owner: setting.WalletAddress, Hash: c.info.FileHash}.String()
+		manifestId, _ := utils.NextSnowFlakeId()
+		manifestPath := filepath.Join(file.GetManifestFolderPath(), strconv.FormatInt(manifestId, 10), c.info.FileHash)
+		err = downloadFileAndWait(ctx, handle, c.info.FileHash, "", manifestPath)
+		var manifest *file.VersionManifest
+		if err == nil {
+			manifest, err = file.LoadVersionManifest(manifestPath, setting.WalletAddress, key)
+		}
+		_ = os.RemoveAll(filepath.Dir(manifestPath))
+		if err == nil && manifest.Sequence != c.sequence {
+			err = errors.New("the sequence of the version manifest doesn't match its name")
+		}
+		if err != nil {
+			pp.ErrorLogf(ctx, "skipping version manifest %v: %v", c.info.FileName, err)
+			continue
+		}
+		return manifest, nil
+	}
+	if len(candidates) > 0 {
+		return nil, errors.Errorf("none of the %v version manifests of object %v could be loaded", len(candidates), key)
+	}
+	return nil, nil
+}
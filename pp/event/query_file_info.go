@@ -152,6 +152,13 @@ func RspFileStorageInfo(ctx context.Context, conn core.WriteCloser) {
 		return
 	}
 
+	if !rpcRequested && file.IsContentKeyEncrypted(target.EncryptionTag) {
+		if _, err := file.RecoverContentKey(target.FileHash, target.WrappedContentKey); err != nil {
+			task.DownloadResult(ctx, target.FileHash, false, "the file is encrypted, "+err.Error())
+			return
+		}
+	}
+
 	task.CleanDownloadFileAndConnMap(ctx, target.FileHash, fileReqId)
 	task.DownloadFileMap.Store(target.FileHash+fileReqId, newTarget)
 	task.AddDownloadTask(newTarget)
@@ -0,0 +1,87 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/alex023/clock"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/msg/header"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/p2pserver"
+	"github.com/stratosnet/sds/pp/requests"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+// max number of slices in one report to the SP
+const CORRUPTED_SLICES_PER_REPORT = 100
+
+var (
+	scrubSliceClock = clock.NewClock()
+	scrubSliceJob   clock.Job
+)
+
+func StartScrubSliceJob(ctx context.Context) {
+	if !setting.Config.Node.Scrub.Enabled || setting.Config.Node.Scrub.IntervalHours == 0 {
+		return
+	}
+	utils.Log("Starting ScrubSliceJob......")
+	interval := time.Hour * time.Duration(setting.Config.Node.Scrub.IntervalHours)
+	scrubSliceJob, _ = scrubSliceClock.AddJobRepeat(interval, 0, func() {
+		if err := ScrubSlices(ctx); err != nil {
+			utils.ErrorLog("failed verifying the stored slices", err.Error())
+		}
+	})
+}
+
+func StopScrubSliceJob() {
+	file.StopSliceScrub()
+	if scrubSliceJob != nil {
+		utils.Log("Stopping ScrubSliceJob......")
+		scrubSliceJob.Cancel()
+	}
+}
+
+// ScrubSlices verifies all the stored slices, and reports the corrupted ones to the SP so they can be replicated again
+func ScrubSlices(ctx context.Context) error {
+	corrupted, err := file.ScrubSlices(setting.Config.Node.Scrub.IoBudget * 1024 * 1024)
+	// the slices found before an interruption are already removed, they are reported anyway
+	for len(corrupted) > 0 {
+		n := len(corrupted)
+		if n > CORRUPTED_SLICES_PER_REPORT {
+			n = CORRUPTED_SLICES_PER_REPORT
+		}
+		sendReportCorruptedSlices(ctx, corrupted[:n])
+		corrupted = corrupted[n:]
+	}
+	return err
+}
+
+func sendReportCorruptedSlices(ctx context.Context, slices []*protos.CorruptedSlice) {
+	req := &protos.ReqReportCorruptedSlices{
+		P2PAddress: p2pserver.GetP2pServer(ctx).GetP2PAddress().String(),
+		Slices:     slices,
+	}
+	utils.DebugLogf("---sendReportCorruptedSlices, %v slices", len(slices))
+	p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, req, header.ReqReportCorruptedSlices)
+}
+
+// RspReportCorruptedSlices
+func RspReportCorruptedSlices(ctx context.Context, conn core.WriteCloser) {
+	var target protos.RspReportCorruptedSlices
+	if err := VerifyMessage(ctx, header.RspReportCorruptedSlices, &target); err != nil {
+		utils.ErrorLog("failed verifying the message, ", err.Error())
+		return
+	}
+	if !requests.UnmarshalData(ctx, &target) {
+		return
+	}
+	if target.Result.State != protos.ResultState_RES_SUCCESS {
+		utils.ErrorLog("failed reporting corrupted slices", target.Result.Msg)
+		return
+	}
+	utils.Logf("%v corrupted slices will be replicated again", len(target.SliceHashes))
+}
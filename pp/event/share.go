@@ -5,9 +5,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/stratosnet/sds/framework/core"
 	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/framework/crypto/encryption"
 	"github.com/stratosnet/sds/framework/msg/header"
+	fwtypes "github.com/stratosnet/sds/framework/types"
 	fwutils "github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/pp"
 	"github.com/stratosnet/sds/pp/api/rpc"
@@ -24,8 +28,20 @@ var (
 
 	// key: fileHash + fileReqId; value: sdm (already got translated from share link)
 	sdmMap = &sync.Map{}
+
+	// key: reqId of the share request; value: encoded recipients of the share, to be appended to the share link
+	shareRecipientKeys = &sync.Map{}
+
+	// key: keyword of the share link; value: *shareLinkRequest, the recipients carried by the link and the requester
+	shareLinkRecipients = &sync.Map{}
 )
 
+// shareLinkRequest a request to download a share, checked against the recipients of the share once they are received
+type shareLinkRequest struct {
+	recipientKeys string
+	walletAddress string
+}
+
 func GetAllShareLink(ctx context.Context, walletAddr string, page uint64, walletPubkey, wsign []byte, reqTime int64) {
 	if setting.CheckLogin() {
 		p2pserver.GetP2pServer(ctx).SendMessageToSPServer(
@@ -53,6 +69,72 @@ func ReqShareFile(ctx context.Context, fileHash, pathHash, walletAddr string, sh
 	}
 }
 
+// ShareRecipient a wallet that a file is shared to
+type ShareRecipient struct {
+	WalletAddress string
+	// EncryptionPubkey the key of the wallet to wrap the content key of the file for, when the file is encrypted
+	EncryptionPubkey []byte
+	// WrappedKey the content key of the file already wrapped for the recipient, when it isn't known by this node
+	WrappedKey []byte
+}
+
+// ShareRecipientAddresses the wallet addresses of the recipients, as covered by the wallet signature of the share
+func ShareRecipientAddresses(recipients []ShareRecipient) []string {
+	var addresses []string
+	for _, recipient := range recipients {
+		addresses = append(addresses, recipient.WalletAddress)
+	}
+	return addresses
+}
+
+// ReqShareFileToRecipients shares a file to specific wallets. The content key of the file, if this node has it, is
+// wrapped for each of them. The recipients are signed by the owner and stored by the SP with the share, which only
+// serves the file to them. They are also added to the share link once it is returned by the SP
+func ReqShareFileToRecipients(ctx context.Context, fileHash, walletAddr string, shareTime int64, isPrivate bool,
+	recipients []ShareRecipient, walletPubkey, wsign []byte, reqTime int64, ipfsCid string) error {
+	if len(recipients) == 0 {
+		return errors.New("no recipient to share the file to")
+	}
+	var contentKey []byte
+	if walletAddr == setting.WalletAddress {
+		contentKey, _ = file.GetContentKey(fileHash)
+	}
+
+	keys := &protos.ShareKeys{}
+	for _, recipient := range recipients {
+		if recipient.WalletAddress == "" {
+			return errors.New("the recipients of a share should be wallet addresses")
+		}
+		entry := &protos.ShareRecipientKey{WalletAddress: recipient.WalletAddress, WrappedKey: recipient.WrappedKey}
+		if contentKey != nil && entry.WrappedKey == nil {
+			if recipient.EncryptionPubkey == nil {
+				return errors.Errorf("the file is encrypted, the encryption public key of %v is required", recipient.WalletAddress)
+			}
+			wrappedKey, err := encryption.WrapContentKey(contentKey, recipient.EncryptionPubkey)
+			if err != nil {
+				return err
+			}
+			entry.WrappedKey = wrappedKey
+		}
+		keys.Recipients = append(keys.Recipients, entry)
+	}
+	encodedKeys, err := file.EncodeShareKeys(keys)
+	if err != nil {
+		return err
+	}
+	if !setting.CheckLogin() {
+		return nil
+	}
+	shareRecipientKeys.Store(core.GetReqIdFromContext(ctx), encodedKeys)
+	req := requests.ReqShareFileData(
+		fileHash, "", walletAddr, p2pserver.GetP2pServer(ctx).GetP2PAddress().String(),
+		isPrivate, shareTime, walletPubkey, wsign, reqTime, ipfsCid,
+	)
+	req.Recipients = keys
+	p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, req, header.ReqShareFile)
+	return nil
+}
+
 func DeleteShare(ctx context.Context, shareID, walletAddress string, walletPubkey, wsign []byte, reqTime int64) {
 	if setting.CheckLogin() {
 		p2pserver.GetP2pServer(ctx).SendMessageToSPServer(
@@ -149,6 +231,7 @@ func RspShareFile(ctx context.Context, conn core.WriteCloser) {
 		return
 	}
 
+	recipientKeys, _ := shareRecipientKeys.LoadAndDelete(core.GetReqIdFromContext(ctx))
 	if target.Result.State == protos.ResultState_RES_SUCCESS {
 		pp.Log(ctx, "ShareId", target.ShareId)
 		pp.Log(ctx, "ShareLink", target.ShareLink)
@@ -156,6 +239,12 @@ func RspShareFile(ctx context.Context, conn core.WriteCloser) {
 		rpcResult.Return = rpc.SUCCESS
 		rpcResult.ShareId = target.ShareId
 		rpcResult.ShareLink = target.ShareLink
+		if recipientKeys != nil {
+			// the recipients are only known from the link, so it is given in full
+			link := fwtypes.ShareDataMeshId{Link: target.ShareLink, Password: target.SharePassword, RecipientKeys: recipientKeys.(string)}
+			pp.Log(ctx, "RecipientShareLink", link.FullLink())
+			rpcResult.ShareLink = link.FullLink()
+		}
 	} else {
 		pp.ErrorLog(ctx, "share file failed:", target.Result.Msg)
 		rpcResult.Return = target.Result.Msg
@@ -204,6 +293,55 @@ func GetShareFile(ctx context.Context, keyword, sharePassword, saveAs, walletAdd
 	}
 }
 
+// SaveShareLinkRecipients keeps the recipients carried by a share link, and the wallet which signed the request to
+// download it, until the info of the shared file is received
+func SaveShareLinkRecipients(keyword, recipientKeys, walletAddress string) error {
+	if recipientKeys != "" {
+		if _, err := file.DecodeShareKeys(recipientKeys); err != nil {
+			return err
+		}
+	}
+	shareLinkRecipients.Store(keyword, &shareLinkRequest{recipientKeys: recipientKeys, walletAddress: walletAddress})
+	return nil
+}
+
+// checkShareRecipients checks a download of a share against the recipients stored by the SP, and returns the content
+// key wrapped for the wallet, if any. A share bound to wallets can only be downloaded by them, with a share link still
+// carrying the same recipients
+func checkShareRecipients(recipients *protos.ShareKeys, linkRequest *shareLinkRequest) ([]byte, error) {
+	if linkRequest == nil {
+		linkRequest = &shareLinkRequest{}
+	}
+	if len(recipients.GetRecipients()) == 0 {
+		if linkRequest.recipientKeys != "" {
+			return nil, errors.New("the share link has recipients, but the share isn't bound to them")
+		}
+		return nil, nil
+	}
+	if linkRequest.recipientKeys == "" {
+		return nil, errors.New("the share is bound to wallets, but the share link has no recipients")
+	}
+	linkKeys, err := file.DecodeShareKeys(linkRequest.recipientKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(linkKeys.Recipients) != len(recipients.Recipients) {
+		return nil, errors.New("the recipients of the share link don't match the share")
+	}
+	for i, recipient := range recipients.Recipients {
+		if linkKeys.Recipients[i].WalletAddress != recipient.WalletAddress {
+			return nil, errors.New("the recipients of the share link don't match the share")
+		}
+	}
+
+	for _, recipient := range recipients.Recipients {
+		if linkRequest.walletAddress != "" && recipient.WalletAddress == linkRequest.walletAddress {
+			return recipient.WrappedKey, nil
+		}
+	}
+	return nil, errors.Errorf("the file isn't shared to wallet %v", linkRequest.walletAddress)
+}
+
 func RspGetShareFile(ctx context.Context, _ core.WriteCloser) {
 	var target protos.RspFileStorageInfo
 	if err := VerifyMessage(ctx, header.RspGetShareFile, &target); err != nil {
@@ -241,6 +379,32 @@ func RspGetShareFile(ctx context.Context, _ core.WriteCloser) {
 		return
 	}
 
+	var linkRequest *shareLinkRequest
+	if value, ok := shareLinkRecipients.LoadAndDelete(target.KeyWord); ok {
+		linkRequest = value.(*shareLinkRequest)
+	}
+	wrappedKey, err := checkShareRecipients(target.ShareRecipients, linkRequest)
+	if err != nil {
+		task.DownloadResult(ctx, target.FileHash, false, "failed ReqGetSharedFile, "+err.Error())
+		rpcResult.Return = rpc.WRONG_INPUT
+		rpcResult.Detail = err.Error()
+		return
+	}
+
+	if file.IsContentKeyEncrypted(target.EncryptionTag) {
+		if len(wrappedKey) > 0 {
+			if err = file.SaveWrappedContentKey(target.FileHash, wrappedKey); err != nil {
+				pp.ErrorLog(ctx, "failed receiving the content key of the shared file", err.Error())
+			}
+		}
+		if _, err := file.RecoverContentKey(target.FileHash, target.WrappedContentKey); err != nil {
+			task.DownloadResult(ctx, target.FileHash, false, "the shared file is encrypted, "+err.Error())
+			rpcResult.Return = rpc.INTERNAL_DATA_FAILURE
+			rpcResult.Detail = err.Error()
+			return
+		}
+	}
+
 	task.CleanDownloadFileAndConnMap(ctx, target.FileHash, reqId)
 	task.DownloadFileMap.Store(target.FileHash+reqId, newTarget)
 	task.AddDownloadTask(newTarget)
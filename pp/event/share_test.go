@@ -0,0 +1,61 @@
+package event
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestCheckShareRecipients(t *testing.T) {
+	stored := &protos.ShareKeys{Recipients: []*protos.ShareRecipientKey{
+		{WalletAddress: "st1alice", WrappedKey: []byte("alice")},
+		{WalletAddress: "st1bob"},
+	}}
+	linkKeys, err := file.EncodeShareKeys(&protos.ShareKeys{Recipients: []*protos.ShareRecipientKey{
+		{WalletAddress: "st1alice", WrappedKey: []byte("alice")},
+		{WalletAddress: "st1bob"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := checkShareRecipients(stored, &shareLinkRequest{recipientKeys: linkKeys, walletAddress: "st1alice"})
+	if err != nil || !bytes.Equal(key, []byte("alice")) {
+		t.Fatal("wrong key for a recipient wallet", key, err)
+	}
+	if key, err = checkShareRecipients(stored, &shareLinkRequest{recipientKeys: linkKeys, walletAddress: "st1bob"}); err != nil || key != nil {
+		t.Fatal("a recipient of an unencrypted file should be accepted", key, err)
+	}
+	if _, err = checkShareRecipients(stored, &shareLinkRequest{recipientKeys: linkKeys, walletAddress: "st1carol"}); err == nil {
+		t.Fatal("a wallet which isn't a recipient shouldn't be accepted")
+	}
+	if _, err = checkShareRecipients(stored, &shareLinkRequest{recipientKeys: linkKeys}); err == nil {
+		t.Fatal("an unknown wallet shouldn't be accepted")
+	}
+	if _, err = checkShareRecipients(stored, &shareLinkRequest{walletAddress: "st1alice"}); err == nil {
+		t.Fatal("a share link without its recipients shouldn't be accepted")
+	}
+	if _, err = checkShareRecipients(stored, nil); err == nil {
+		t.Fatal("a download without a share link request shouldn't be accepted")
+	}
+
+	otherKeys, err := file.EncodeShareKeys(&protos.ShareKeys{Recipients: []*protos.ShareRecipientKey{
+		{WalletAddress: "st1carol"},
+		{WalletAddress: "st1bob"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = checkShareRecipients(stored, &shareLinkRequest{recipientKeys: otherKeys, walletAddress: "st1carol"}); err == nil {
+		t.Fatal("recipients of the share link not matching the stored ones shouldn't be accepted")
+	}
+
+	if key, err = checkShareRecipients(nil, &shareLinkRequest{walletAddress: "st1carol"}); err != nil || key != nil {
+		t.Fatal("a share which isn't bound to wallets should be accepted", key, err)
+	}
+	if _, err = checkShareRecipients(nil, &shareLinkRequest{recipientKeys: linkKeys, walletAddress: "st1alice"}); err == nil {
+		t.Fatal("recipients added to the link of a share which isn't bound to wallets shouldn't be accepted")
+	}
+}
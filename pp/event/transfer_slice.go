@@ -17,6 +17,7 @@ import (
 	"github.com/stratosnet/sds/pp/requests"
 	"github.com/stratosnet/sds/pp/setting"
 	"github.com/stratosnet/sds/pp/task"
+	"github.com/stratosnet/sds/pp/tracing"
 	"github.com/stratosnet/sds/sds-msg/protos"
 )
 
@@ -66,9 +67,15 @@ func NoticeFileSliceBackup(ctx context.Context, conn core.WriteCloser) {
 	}
 	task.AddTransferTask(target.TaskId, target.SliceStorageInfo.SliceHash, tTask)
 
+	ctx, span := tracing.StartSlice(ctx, "transfer slice", target.FileHash, target.SliceStorageInfo.SliceHash,
+		target.PpInfo.P2PAddress)
+	defer span.End()
+
 	//if the connection returns error, send a ReqTransferDownloadWrong message to sp to report the failure
+	p2pserver.GetP2pServer(ctx).StorePpAddresses(target.PpInfo)
 	err := p2pserver.GetP2pServer(ctx).TransferSendMessageToPPServ(ctx, target.PpInfo.NetworkAddress, requests.ReqTransferDownloadData(ctx, target))
 	if err != nil {
+		span.RecordError(err)
 		p2pserver.GetP2pServer(ctx).SendMessageToSPServer(ctx, requests.ReqTransferDownloadWrongData(ctx, target), header.ReqTransferDownloadWrong)
 	}
 }
@@ -87,6 +94,9 @@ func ReqTransferDownload(ctx context.Context, conn core.WriteCloser) {
 	setWriteHookForRspTransferSlice(conn)
 
 	noticeFileSliceBackup := target.NoticeFileSliceBackup
+	ctx, span := tracing.StartSlice(ctx, "serve slice transfer", noticeFileSliceBackup.FileHash,
+		noticeFileSliceBackup.SliceStorageInfo.SliceHash, target.NewPp.P2PAddress)
+	defer span.End()
 
 	// spam check
 	key := noticeFileSliceBackup.TaskId + strconv.FormatInt(int64(noticeFileSliceBackup.SliceNumber), 10) +
@@ -0,0 +1,263 @@
+package event
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/core"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/pp/task"
+)
+
+const (
+	DEFAULT_DIRECTORY_UPLOAD_CONCURRENCY = 4
+	MAX_DIRECTORY_UPLOAD_CONCURRENCY     = 16
+
+	// time allowed between the upload request and the creation of the upload task
+	uploadStartTimeout = 3 * time.Minute
+
+	// sameFileMsg starts the answer of the SP to the upload of a file the wallet already owns
+	sameFileMsg = "Same file with the name"
+)
+
+var (
+	// uploadDoneSubscribers fileHash -> []chan error, notified once the upload of a file is over
+	uploadDoneSubscribers = make(map[string][]chan error)
+	uploadDoneMutex       sync.Mutex
+
+	// replaced in the tests, which upload without a SP
+	prepareUpload     = prepareUploadFile
+	sendUploadRequest = ReqGetWalletOzForUpload
+)
+
+// DirectoryUpload is the result of the upload of a directory
+type DirectoryUpload struct {
+	ManifestHandle string            // file handle of the uploaded manifest, empty if some file failed
+	Files          map[string]string // relative path -> file handle
+	Failed         map[string]string // relative path -> error message
+	EncryptionTags map[string]string // file handle -> encryption tag, for the encrypted files
+}
+
+type pendingUpload struct {
+	done   chan struct{}
+	handle string
+	err    error
+}
+
+func subscribeUploadDone(fileHash string) chan error {
+	uploadDoneMutex.Lock()
+	defer uploadDoneMutex.Unlock()
+	ch := make(chan error, 1)
+	uploadDoneSubscribers[fileHash] = append(uploadDoneSubscribers[fileHash], ch)
+	return ch
+}
+
+func unsubscribeUploadDone(fileHash string, ch chan error) {
+	uploadDoneMutex.Lock()
+	defer uploadDoneMutex.Unlock()
+	subscribers := uploadDoneSubscribers[fileHash]
+	for i, subscriber := range subscribers {
+		if subscriber == ch {
+			subscribers = append(subscribers[:i], subscribers[i+1:]...)
+			break
+		}
+	}
+	if len(subscribers) == 0 {
+		delete(uploadDoneSubscribers, fileHash)
+	} else {
+		uploadDoneSubscribers[fileHash] = subscribers
+	}
+}
+
+// setUploadDone tells the subscribers that the upload of the file is over. A nil error means the file was uploaded
+func setUploadDone(fileHash string, err error) {
+	uploadDoneMutex.Lock()
+	defer uploadDoneMutex.Unlock()
+	for _, ch := range uploadDoneSubscribers[fileHash] {
+		select {
+		case ch <- err:
+		default:
+		}
+	}
+	delete(uploadDoneSubscribers, fileHash)
+}
+
+// RequestUploadDirectory uploads every file of the directory tree, with at most concurrency files being uploaded at
+// the same time. Once all the files are uploaded, a manifest mapping their relative paths to their file handles is
+// uploaded as well, so the whole directory can be restored from the manifest handle
+func RequestUploadDirectory(ctx context.Context, dirPath string, isEncrypted bool, contentKey []byte, desiredTier uint32,
+	allowHigherTier bool, concurrency int) (*DirectoryUpload, error) {
+	if !setting.CheckLogin() {
+		return nil, errors.New("please login first")
+	}
+	isFile, err := file.IsFile(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if isFile {
+		return nil, errors.New("the provided path indicates a file, not a directory")
+	}
+	if concurrency <= 0 {
+		concurrency = DEFAULT_DIRECTORY_UPLOAD_CONCURRENCY
+	}
+	if concurrency > MAX_DIRECTORY_UPLOAD_CONCURRENCY {
+		concurrency = MAX_DIRECTORY_UPLOAD_CONCURRENCY
+	}
+
+	relPaths, err := file.ListDirectoryFiles(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(relPaths) == 0 {
+		return nil, errors.New("no file to upload in the directory")
+	}
+	pp.Logf(ctx, "uploading %v files from directory %v", len(relPaths), dirPath)
+
+	result := &DirectoryUpload{
+		Files:          make(map[string]string),
+		Failed:         make(map[string]string),
+		EncryptionTags: make(map[string]string),
+	}
+	var (
+		resultMutex sync.Mutex
+		wg          sync.WaitGroup
+		// files with the same content have the same hash, they are only uploaded once
+		pending      = make(map[string]*pendingUpload)
+		pendingMutex sync.Mutex
+		sem          = make(chan struct{}, concurrency)
+	)
+	uploadOne := func(relPath string) {
+		defer wg.Done()
+		defer func() { <-sem }()
+
+		encryptionTag := newEncryptionTag(isEncrypted, contentKey)
+		handle, err := uploadFileAndWait(ctx, filepath.Join(dirPath, filepath.FromSlash(relPath)), "", encryptionTag, contentKey,
+			desiredTier, allowHigherTier, func(fileHash string) (*pendingUpload, bool) {
+				pendingMutex.Lock()
+				defer pendingMutex.Unlock()
+				if p, ok := pending[fileHash]; ok {
+					return p, false
+				}
+				p := &pendingUpload{done: make(chan struct{})}
+				pending[fileHash] = p
+				return p, true
+			})
+
+		resultMutex.Lock()
+		defer resultMutex.Unlock()
+		if err != nil {
+			pp.ErrorLogf(ctx, "failed uploading %v: %v", relPath, err)
+			result.Failed[relPath] = err.Error()
+			return
+		}
+		pp.Logf(ctx, "uploaded %v (%v/%v): %v", relPath, len(result.Files)+1, len(relPaths), handle)
+		result.Files[relPath] = handle
+		if encryptionTag != "" {
+			result.EncryptionTags[handle] = encryptionTag
+		}
+	}
+	for _, relPath := range relPaths {
+		sem <- struct{}{}
+		wg.Add(1)
+		go uploadOne(relPath)
+	}
+	wg.Wait()
+
+	if len(result.Failed) > 0 {
+		return result, errors.Errorf("%v files failed to upload, the manifest is not uploaded", len(result.Failed))
+	}
+
+	manifest := &file.DirectoryManifest{
+		Version: file.DIRECTORY_MANIFEST_VERSION,
+		Name:    filepath.Base(filepath.Clean(dirPath)),
+		Files:   result.Files,
+		// without the tags, the restored files couldn't be told apart from the ones still to download
+		EncryptionTags: result.EncryptionTags,
+	}
+	manifestId, _ := utils.NextSnowFlakeId()
+	manifestPath, err := file.SaveDirectoryManifest(manifest, strconv.FormatInt(manifestId, 10))
+	if err != nil {
+		return result, err
+	}
+	result.ManifestHandle, err = uploadFileAndWait(ctx, manifestPath, "", "", nil, desiredTier, allowHigherTier, nil)
+	if err != nil {
+		return result, errors.Wrap(err, "failed uploading the manifest")
+	}
+	pp.Logf(ctx, "directory %v uploaded, manifest: %v", dirPath, result.ManifestHandle)
+	return result, nil
+}
+
+// uploadFileAndWait uploads a local file and blocks until its upload is over, and returns its handle. The file is named
+// after the local file, unless a file name is given. A file the wallet already owns isn't uploaded again, its handle is
+// returned. dedup is called with the hash of the file, and returns whether this call is in charge of the upload.
+// Otherwise, the result of the other upload is used
+func uploadFileAndWait(ctx context.Context, path, fileName, encryptionTag string, contentKey []byte, desiredTier uint32, allowHigherTier bool,
+	dedup func(fileHash string) (*pendingUpload, bool)) (handle string, err error) {
+	// each file needs its own request id, since the wallet signature is matched to the upload request by request id
+	reqId, _ := utils.NextSnowFlakeId()
+	core.InheritRpcLoggerFromParentReqId(ctx, reqId)
+	fileCtx := core.CreateContextWithReqId(ctx, reqId)
+
+	p, err := prepareUpload(fileCtx, path, encryptionTag, contentKey, false, desiredTier, allowHigherTier,
+		setting.WalletAddress, setting.WalletPublicKey.Bytes(), nil)
+	if err != nil {
+		return "", err
+	}
+	if fileName != "" {
+		p.FileInfo.FileName = fileName
+	}
+	fileHash := p.FileInfo.FileHash
+
+	if dedup != nil {
+		pending, owner := dedup(fileHash)
+		if !owner {
+			<-pending.done
+			return pending.handle, pending.err
+		}
+		defer func() {
+			pending.handle, pending.err = handle, err
+			close(pending.done)
+		}()
+	}
+
+	done := subscribeUploadDone(fileHash)
+	defer unsubscribeUploadDone(fileHash, done)
+	if err = sendUploadRequest(fileCtx, setting.WalletAddress, task.LOCAL_REQID, p); err != nil {
+		return "", err
+	}
+	if err = waitUploadDone(fileHash, done); err != nil {
+		return "", err
+	}
+	return fwtypes.DataMeshId{Owner: setting.WalletAddress, Hash: fileHash}.String(), nil
+}
+
+func waitUploadDone(fileHash string, done chan error) error {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+	start := time.Now()
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-ticker.C:
+			if _, ok := task.UploadFileTaskMap.Load(fileHash); ok || time.Since(start) < uploadStartTimeout {
+				continue
+			}
+			select {
+			case err := <-done:
+				return err
+			default:
+				return errors.New("timeout waiting for the upload to start")
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/crypto/secp256k1"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+func TestResumeDirectoryUpload(t *testing.T) {
+	utils.NewDefaultLogger("", false, false)
+	if err := utils.InitIdWorker(1); err != nil {
+		t.Fatal(err)
+	}
+	privateKey, err := secp256k1.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setting.WalletPrivateKey = privateKey
+	setting.WalletPublicKey = privateKey.PubKey()
+	setting.WalletAddress = fwtypes.WalletAddress(privateKey.PubKey().Address()).String()
+	setting.Config = setting.DefaultConfig()
+	setting.SetupRoot(t.TempDir())
+
+	dirPath := t.TempDir()
+	for name, content := range map[string]string{"a.txt": "first file", "b.txt": "second file"} {
+		if err = os.WriteFile(filepath.Join(dirPath, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the SP refuses the files with no space left, and the files already owned by the wallet
+	var (
+		spMutex sync.Mutex
+		owned   = make(map[string]bool)
+		noSpace = map[string]bool{"b.txt": true}
+	)
+	prepare, send := prepareUpload, sendUploadRequest
+	defer func() {
+		prepareUpload, sendUploadRequest = prepare, send
+	}()
+	prepareUpload = func(ctx context.Context, path, encryptionTag string, contentKey []byte, isVideoStream bool,
+		desiredTier uint32, allowHigherTier bool, walletAddr string, walletPubkey, wsign []byte) (*protos.ReqUploadFile, error) {
+		fileInfo := &protos.FileInfo{FileHash: file.GetFileHash(path, encryptionTag), FileName: filepath.Base(path)}
+		return &protos.ReqUploadFile{FileInfo: fileInfo}, nil
+	}
+	sendUploadRequest = func(ctx context.Context, walletAddr, reqId string, req *protos.ReqUploadFile) error {
+		go func() {
+			spMutex.Lock()
+			defer spMutex.Unlock()
+			fileHash, fileName := req.FileInfo.FileHash, req.FileInfo.FileName
+			switch {
+			case noSpace[fileName]:
+				uploadRejected(ctx, fileHash, "no enough space")
+			case owned[fileHash]:
+				uploadRejected(ctx, fileHash, sameFileMsg+": "+fileName+" already exists")
+			default:
+				owned[fileHash] = true
+				setUploadDone(fileHash, nil)
+			}
+		}()
+		return nil
+	}
+
+	result, err := RequestUploadDirectory(context.Background(), dirPath, false, nil, 0, true, 2)
+	if err == nil || result.ManifestHandle != "" {
+		t.Fatal("the manifest shouldn't be uploaded when a file failed")
+	}
+	if _, ok := result.Failed["b.txt"]; !ok || len(result.Files) != 1 {
+		t.Fatal("wrong result of the partial upload", result.Files, result.Failed)
+	}
+	firstHandle := result.Files["a.txt"]
+
+	spMutex.Lock()
+	noSpace = map[string]bool{}
+	spMutex.Unlock()
+	result, err = RequestUploadDirectory(context.Background(), dirPath, false, nil, 0, true, 2)
+	if err != nil {
+		t.Fatal("the upload should resume with the file already owned: " + err.Error())
+	}
+	if len(result.Failed) != 0 || len(result.Files) != 2 || result.ManifestHandle == "" {
+		t.Fatal("wrong result of the resumed upload", result.Files, result.Failed)
+	}
+	if result.Files["a.txt"] != firstHandle {
+		t.Fatal("the file already owned should keep its handle", result.Files["a.txt"], firstHandle)
+	}
+}
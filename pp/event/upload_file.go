@@ -38,38 +38,67 @@ var (
 )
 
 // RequestUploadFile request to SP for upload file
-func RequestUploadFile(ctx context.Context, path string, isEncrypted, isVideoStream bool, desiredTier uint32, allowHigherTier bool,
-	walletAddr string, walletPubkey, wsign []byte) {
+func RequestUploadFile(ctx context.Context, path string, isEncrypted bool, contentKey []byte, isVideoStream bool, desiredTier uint32,
+	allowHigherTier bool, walletAddr string, walletPubkey, wsign []byte) {
 	pp.DebugLog(ctx, "______________path", path)
 	if !setting.CheckLogin() {
 		return
 	}
 
-	isFile, err := file.IsFile(path)
+	p, err := prepareUploadFile(ctx, path, newEncryptionTag(isEncrypted, contentKey), contentKey, isVideoStream, desiredTier, allowHigherTier,
+		walletAddr, walletPubkey, wsign)
 	if err != nil {
 		pp.ErrorLog(ctx, err)
 		return
 	}
-	if !isFile {
-		pp.ErrorLog(ctx, "the provided path indicates a directory, not a file")
-		return
+	if err = ReqGetWalletOzForUpload(ctx, setting.WalletAddress, task.LOCAL_REQID, p); err != nil {
+		pp.ErrorLog(ctx, err)
+	}
+}
+
+// newEncryptionTag the random encryption tag of a new upload, empty when the file isn't encrypted
+func newEncryptionTag(isEncrypted bool, contentKey []byte) string {
+	if contentKey != nil {
+		return file.CONTENT_KEY_TAG_PREFIX + utils.GetRandomString(8)
 	}
-	encryptionTag := ""
 	if isEncrypted {
-		encryptionTag = utils.GetRandomString(8)
+		return utils.GetRandomString(8)
+	}
+	return ""
+}
+
+// prepareUploadFile slices the file into the tmp folder and builds the upload request to be sent to SP. The file is
+// encrypted when the encryption tag isn't empty. When a content key is given, the file is encrypted with it instead of
+// keys derived from the wallet, so that it can be shared
+func prepareUploadFile(ctx context.Context, path, encryptionTag string, contentKey []byte, isVideoStream bool, desiredTier uint32,
+	allowHigherTier bool, walletAddr string, walletPubkey, wsign []byte) (*protos.ReqUploadFile, error) {
+	isFile, err := file.IsFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !isFile {
+		return nil, errors.New("the provided path indicates a directory, not a file")
 	}
 	uploadFileHandler := GetUploadFileHandler(isVideoStream)
-	fileInfo, slices, err := uploadFileHandler.PreUpload(ctx, path, encryptionTag)
+	fileInfo, slices, err := uploadFileHandler.PreUpload(ctx, path, encryptionTag, contentKey)
 	if err != nil {
-		pp.ErrorLog(ctx, "failed to slice file before upload ", err)
-		return
+		return nil, errors.Wrap(err, "failed to slice file before upload")
+	}
+	if contentKey != nil {
+		// the SP stores the wrapped key with the file, the local copy saves fetching it for the next downloads
+		fileInfo.WrappedContentKey, err = file.WrapContentKeyForWallet(contentKey)
+		if err != nil {
+			return nil, err
+		}
+		if err = file.SaveContentKey(fileInfo.FileHash, contentKey); err != nil {
+			return nil, errors.Wrap(err, "failed to save the content key")
+		}
 	}
 
 	reqTime := time.Now().Unix()
-	p := requests.RequestUploadFileData(ctx, fileInfo, slices, desiredTier, allowHigherTier, walletAddr, walletPubkey, wsign, reqTime)
-	if err = ReqGetWalletOzForUpload(ctx, setting.WalletAddress, task.LOCAL_REQID, p); err != nil {
-		pp.ErrorLog(ctx, err)
-	}
+	req := requests.RequestUploadFileData(ctx, fileInfo, slices, desiredTier, allowHigherTier, walletAddr, walletPubkey, wsign, reqTime)
+	req.ContentDefinedSlices = !isVideoStream && setting.Config.Node.Chunking.ContentDefined
+	return req, nil
 }
 
 func ScheduleReqBackupStatus(ctx context.Context, fileHash string) {
@@ -114,23 +143,13 @@ func RspUploadFile(ctx context.Context, _ core.WriteCloser) {
 	}
 
 	if target.Result.State != protos.ResultState_RES_SUCCESS {
-		if strings.Contains(target.Result.Msg, "Same file with the name") {
-			pp.ErrorLog(ctx, target.Result.Msg)
-		} else {
-			pp.ErrorLog(ctx, "upload failed: ", target.Result.Msg)
-		}
-
-		if file.IsFileRpcRemote(target.FileHash) {
-			_ = file.SetRemoteFileResult(target.FileHash, rpc.Result{Return: target.Result.Msg})
-		} else {
-			file.ClearFileMap(target.FileHash)
-		}
+		uploadRejected(ctx, target.FileHash, target.Result.Msg)
 		return
 	}
 
 	task.UploadTaskIdMap.Store(target.FileHash, target.TaskId)
 
-	if len(target.Slices) != 0 {
+	if skipStoredSlices(ctx, target) != 0 {
 		// create the upload file task
 		uploadTask := task.CreateUploadFileTask(target, uploadTaskHelper)
 		p2pserver.GetP2pServer(ctx).CleanUpConnMap(target.FileHash)
@@ -142,6 +161,7 @@ func RspUploadFile(ctx context.Context, _ core.WriteCloser) {
 		//var p float32 = 100
 		//ProgressMap.Store(target.FileHash, p)
 		task.UploadProgressMap.Delete(target.FileHash)
+		setUploadDone(target.FileHash, nil)
 	}
 
 	// tell the rpc client, uploading to sds network has successfully started.
@@ -154,6 +174,49 @@ func RspUploadFile(ctx context.Context, _ core.WriteCloser) {
 	}
 }
 
+// uploadRejected ends the upload of a file refused by the SP. A file the wallet already owns counts as uploaded, so
+// uploading it again gives its handle
+func uploadRejected(ctx context.Context, fileHash, msg string) {
+	if file.IsFileRpcRemote(fileHash) {
+		_ = file.SetRemoteFileResult(fileHash, rpc.Result{Return: msg})
+	} else {
+		file.ClearFileMap(fileHash)
+	}
+	if strings.Contains(msg, sameFileMsg) {
+		pp.Log(ctx, msg)
+		setUploadDone(fileHash, nil)
+		return
+	}
+	pp.ErrorLog(ctx, "upload failed: ", msg)
+	setUploadDone(fileHash, errors.New(msg))
+}
+
+// skipStoredSlices counts the slices already stored in the network as uploaded, and returns the number of slices left
+// to be sent
+func skipStoredSlices(ctx context.Context, target *protos.RspUploadFile) int {
+	stored := make(map[string]bool)
+	for _, sliceHash := range target.StoredSliceHashes {
+		stored[sliceHash] = true
+	}
+	pending := 0
+	var skippedSize int64
+	for _, slice := range target.Slices {
+		if stored[slice.SliceHash] {
+			skippedSize += int64(slice.SliceSize)
+			continue
+		}
+		pending++
+	}
+	if skippedSize == 0 {
+		return pending
+	}
+	pp.Logf(ctx, "%v slices of file %v are already stored, they won't be sent", len(target.Slices)-pending, target.FileHash)
+	if progress, ok := task.UploadProgressMap.Load(target.FileHash); ok {
+		progress.(*task.UploadProgress).HasUpload += skippedSize
+	}
+	return pending
+}
+
 func RspBackupStatus(ctx context.Context, _ core.WriteCloser) {
 	pp.DebugLog(ctx, "get RspBackupStatus")
 	target := &protos.RspBackupStatus{}
@@ -244,10 +307,16 @@ func uploadTaskHelper(ctx context.Context, fileHash string) {
 	if errors.Is(err, task.UploadErrMaxRetries) || errors.Is(err, task.UploadFinished) || errors.Is(err, task.UploadErrFatalError) {
 		task.StopRepeatedUploadTaskJob(fileHash)
 		task.UploadFileTaskMap.Delete(fileHash)
+		task.DeleteUploadTaskRecord(fileHash)
+		if errors.Is(err, task.UploadFinished) {
+			err = nil
+		}
+		setUploadDone(fileHash, err)
 		return
 	}
 	if errors.Is(err, task.UploadErrNoUploadTask) {
 		task.StopRepeatedUploadTaskJob(fileHash)
+		setUploadDone(fileHash, err)
 		return
 	}
 }
@@ -279,6 +348,10 @@ func uploadTaskHandler(ctx context.Context, fileHash string) error {
 	}
 
 	if uploadTask.GetState() == task.STATE_PAUSED {
+		if !p2pserver.GetP2pServer(ctx).SpConnValid() {
+			// wait for the SP connection before requesting new destinations
+			return nil
+		}
 		uploadTask.SetState(task.STATE_NOT_STARTED)
 		uploadTask.Continue()
 		slicesToReUpload, failedSlices := uploadTask.SliceFailuresToReport()
@@ -311,9 +384,42 @@ func UploadPause(ctx context.Context, fileHash, reqID string, w http.ResponseWri
 	p2pserver.GetP2pServer(ctx).CleanUpConnMap(fileHash)
 	task.UploadFileTaskMap.Delete(fileHash)
 	task.UploadProgressMap.Delete(fileHash)
+	task.DeleteUploadTaskRecord(fileHash)
+	setUploadDone(fileHash, errors.New("the upload was paused"))
+}
+
+// ResumeUploadTasks reloads the upload tasks that were interrupted by a restart of the node. New destinations are
+// requested from the SP for the slices that were never confirmed, the other slices are not uploaded again
+func ResumeUploadTasks(ctx context.Context) {
+	for _, uploadTask := range task.LoadUploadTaskRecords(uploadTaskHelper) {
+		fileHash := uploadTask.GetFileHash()
+		if uploadTask.IsFinished() {
+			pp.Logf(ctx, "all the slices of file %v were uploaded before the restart", fileHash)
+			task.DeleteUploadTaskRecord(fileHash)
+			task.UploadProgressMap.Delete(fileHash)
+			ScheduleReqBackupStatus(ctx, fileHash)
+			continue
+		}
+
+		if uploadTask.GetUploadType() == protos.UploadType_BACKUP {
+			// the SP decides again which slices still need a backup
+			task.DeleteUploadTaskRecord(fileHash)
+			ReqBackupStatus(ctx, fileHash)
+			continue
+		}
+
+		pp.Logf(ctx, "resuming the upload of file %v, %.2f %% was uploaded before the restart", fileHash, uploadTask.GetUploadProgress())
+		task.UploadFileTaskMap.Store(fileHash, uploadTask)
+		// the first run of the scheduled job will request new destinations once the SP connection is ready
+		uploadTask.SetScheduledJob(func() { uploadTaskHelper(ctx, fileHash) })
+	}
 }
 
-func encryptSliceData(rawData []byte) ([]byte, error) {
+func encryptSliceData(rawData, contentKey []byte) ([]byte, error) {
+	if contentKey != nil {
+		return encryptSliceDataWithContentKey(rawData, contentKey)
+	}
+
 	hdKeyNonce := rand.Uint32()
 	if hdKeyNonce > hdkey.HardenedKeyStart {
 		hdKeyNonce -= hdkey.HardenedKeyStart
@@ -339,6 +445,25 @@ func encryptSliceData(rawData []byte) ([]byte, error) {
 	return proto.Marshal(encryptedSlice)
 }
 
+func encryptSliceDataWithContentKey(rawData, contentKey []byte) ([]byte, error) {
+	salt, err := encryption.GenerateChunkSalt()
+	if err != nil {
+		return nil, err
+	}
+	encryptedData, err := encryption.EncryptChunks(contentKey, salt, rawData, encryption.DefaultChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedSlice := &protos.ChunkEncryptedSlice{
+		Salt:      salt,
+		ChunkSize: encryption.DefaultChunkSize,
+		Data:      encryptedData,
+		RawSize:   uint64(len(rawData)),
+	}
+	return proto.Marshal(encryptedSlice)
+}
+
 func GetUploadFileHandler(isVideoStream bool) UploadFileHandler {
 	if isVideoStream {
 		return UploadStreamFileHandler{}
@@ -347,7 +472,7 @@ func GetUploadFileHandler(isVideoStream bool) UploadFileHandler {
 }
 
 type UploadFileHandler interface {
-	PreUpload(ctx context.Context, filePath, encryptionTag string) (*protos.FileInfo, []*protos.SliceHashAddr, error)
+	PreUpload(ctx context.Context, filePath, encryptionTag string, contentKey []byte) (*protos.FileInfo, []*protos.SliceHashAddr, error)
 }
 
 type UploadStreamFileHandler struct {
@@ -356,7 +481,7 @@ type UploadStreamFileHandler struct {
 type UploadRawFileHandler struct {
 }
 
-func (UploadStreamFileHandler) PreUpload(ctx context.Context, filePath, encryptionTag string) (*protos.FileInfo, []*protos.SliceHashAddr, error) {
+func (UploadStreamFileHandler) PreUpload(ctx context.Context, filePath, encryptionTag string, contentKey []byte) (*protos.FileInfo, []*protos.SliceHashAddr, error) {
 	info, err := file.GetFileInfo(filePath)
 	if err != nil {
 		pp.ErrorLog(ctx, "wrong filePath", err.Error())
@@ -414,7 +539,7 @@ func (UploadStreamFileHandler) PreUpload(ctx context.Context, filePath, encrypti
 
 		data := rawData
 		if encryptionTag != "" {
-			data, err = encryptSliceData(rawData)
+			data, err = encryptSliceData(rawData, contentKey)
 			if err != nil {
 				return nil, nil, errors.Wrap(err, "Couldn't encrypt slice data")
 			}
@@ -459,7 +584,16 @@ func (UploadStreamFileHandler) PreUpload(ctx context.Context, filePath, encrypti
 	return fileInfo, slices, nil
 }
 
-func (UploadRawFileHandler) PreUpload(ctx context.Context, filePath, encryptionTag string) (*protos.FileInfo, []*protos.SliceHashAddr, error) {
+// newSliceChunker the chunker cutting the files at content defined boundaries, with the slice sizes of the config
+func newSliceChunker() (*file.Chunker, error) {
+	config := setting.Config.Node.Chunking
+	if config.MaxSliceSize*1024*1024 > setting.DefaultSliceBlockSize {
+		return nil, errors.Errorf("max slice size can't be over %v bytes", setting.DefaultSliceBlockSize)
+	}
+	return file.NewChunker(config.MinSliceSize*1024*1024, config.AvgSliceSize*1024*1024, config.MaxSliceSize*1024*1024)
+}
+
+func (UploadRawFileHandler) PreUpload(ctx context.Context, filePath, encryptionTag string, contentKey []byte) (*protos.FileInfo, []*protos.SliceHashAddr, error) {
 	info, err := file.GetFileInfo(filePath)
 	if err != nil {
 		pp.ErrorLog(ctx, "wrong filePath", err.Error())
@@ -473,9 +607,28 @@ func (UploadRawFileHandler) PreUpload(ctx context.Context, filePath, encryptionT
 
 	metrics.UploadPerformanceLogNow(fileHash + ":RCV_CMD_START:")
 
+	var sliceOffsets []*protos.SliceOffset
+	contentDefined := setting.Config.Node.Chunking.ContentDefined
+	if contentDefined {
+		chunker, err := newSliceChunker()
+		if err != nil {
+			return nil, nil, err
+		}
+		sliceOffsets, err = file.SplitContentDefined(filePath, chunker)
+		if err != nil {
+			return nil, nil, err
+		}
+		sliceCount = uint64(len(sliceOffsets))
+	}
+
 	var slices []*protos.SliceHashAddr
 	for sliceNumber := uint64(1); sliceNumber <= sliceCount; sliceNumber++ {
-		sliceOffset := requests.GetSliceOffset(sliceNumber, sliceCount, sliceSize, fileSize)
+		var sliceOffset *protos.SliceOffset
+		if contentDefined {
+			sliceOffset = sliceOffsets[sliceNumber-1]
+		} else {
+			sliceOffset = requests.GetSliceOffset(sliceNumber, sliceCount, sliceSize, fileSize)
+		}
 
 		rawData, err := file.GetFileData(filePath, sliceOffset)
 		if err != nil {
@@ -486,12 +639,17 @@ func (UploadRawFileHandler) PreUpload(ctx context.Context, filePath, encryptionT
 		// Encrypt slice data if required
 		data := rawData
 		if encryptionTag != "" {
-			data, err = encryptSliceData(rawData)
+			data, err = encryptSliceData(rawData, contentKey)
 			if err != nil {
 				return nil, nil, errors.Wrap(err, "Couldn't encrypt slice data")
 			}
 		}
-		sliceHash, err := crypto.CalcSliceHash(data, fileHash, sliceNumber)
+		var sliceHash string
+		if contentDefined {
+			sliceHash, err = crypto.CalcContentSliceHash(data)
+		} else {
+			sliceHash, err = crypto.CalcSliceHash(data, fileHash, sliceNumber)
+		}
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "failed to calc slice hash")
 		}
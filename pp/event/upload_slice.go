@@ -12,7 +12,6 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/stratosnet/sds/framework/core"
-	"github.com/stratosnet/sds/framework/crypto"
 	"github.com/stratosnet/sds/framework/msg/header"
 	"github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/pp"
@@ -22,6 +21,7 @@ import (
 	"github.com/stratosnet/sds/pp/requests"
 	"github.com/stratosnet/sds/pp/setting"
 	"github.com/stratosnet/sds/pp/task"
+	"github.com/stratosnet/sds/pp/tracing"
 	"github.com/stratosnet/sds/sds-msg/protos"
 )
 
@@ -193,12 +193,16 @@ func ReqUploadFileSlice(ctx context.Context, conn core.WriteCloser) {
 			utils.ErrorLog("Failed getting slice data", err.Error())
 			return
 		}
-		sliceHash, err := crypto.CalcSliceHash(sliceData, fileHash, target.SliceNumber)
+		sliceHash, err := file.CalcSliceHashLike(target.SliceHash, sliceData, fileHash, target.SliceNumber)
 		if err != nil {
 			utils.ErrorLog("Failed to calc slice hash", err.Error())
 			return
 		}
 		if sliceHash == target.SliceHash {
+			if err = file.CommitSlice(target.SliceHash, fileHash, target.SliceNumber); err != nil {
+				utils.ErrorLog("Failed committing slice", err.Error())
+				return
+			}
 			_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, requests.RspUploadFileSliceData(ctx, &target), header.RspUploadFileSlice)
 			// report upload result to SP
 			newSlice.SliceHash = target.SliceHash
@@ -378,12 +382,16 @@ func ReqBackupFileSlice(ctx context.Context, conn core.WriteCloser) {
 			utils.ErrorLog("Failed getting slice data", err.Error())
 			return
 		}
-		sliceHash, err := crypto.CalcSliceHash(sliceData, fileHash, target.SliceNumber)
+		sliceHash, err := file.CalcSliceHashLike(target.SliceHash, sliceData, fileHash, target.SliceNumber)
 		if err != nil {
 			utils.ErrorLog("Failed to calc slice hash", err)
 			return
 		}
 		if sliceHash == target.SliceHash {
+			if err = file.CommitSlice(target.SliceHash, fileHash, target.SliceNumber); err != nil {
+				utils.ErrorLog("Failed committing slice", err.Error())
+				return
+			}
 			_ = p2pserver.GetP2pServer(ctx).SendMessage(ctx, conn, requests.RspBackupFileSliceData(&target), header.RspBackupFileSlice)
 			// report upload result to SP
 
@@ -510,10 +518,13 @@ func RspReportUploadSliceResult(ctx context.Context, conn core.WriteCloser) {
 	}
 }
 
-func uploadSlice(ctx context.Context, slice *protos.SliceHashAddr, tk *task.UploadSliceTask, fileHash, taskId string) error {
+func uploadSlice(ctx context.Context, slice *protos.SliceHashAddr, tk *task.UploadSliceTask, fileHash, taskId string) (err error) {
 	tkDataLen := int(slice.SliceOffset.SliceOffsetEnd - slice.SliceOffset.SliceOffsetStart)
 	storageP2pAddress := slice.PpInfo.P2PAddress
+	ctx, span := tracing.StartSlice(ctx, "upload slice", fileHash, tk.SliceHash, storageP2pAddress)
+	defer func() { tracing.End(span, err) }()
 	storageNetworkAddress := slice.PpInfo.NetworkAddress
+	p2pserver.GetP2pServer(ctx).StorePpAddresses(slice.PpInfo)
 	sliceNumber := tk.SliceNumber
 
 	utils.DebugLog("reqID-"+taskId+" =========", strconv.FormatInt(core.GetReqIdFromContext(ctx), 10))
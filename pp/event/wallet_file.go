@@ -0,0 +1,114 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/core"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/pp/api/rpc"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+	msgutils "github.com/stratosnet/sds/sds-msg/utils"
+)
+
+// time allowed for the SP to answer a request about the files of the wallet
+const walletFileTimeout = 30 * time.Second
+
+// UploadWalletFile uploads a file of the node wallet, and blocks until the upload is over. The file is named after the
+// local file unless a file name is given, and it is encrypted with the keys of the wallet when the encryption tag isn't
+// empty. Since the tag is part of the file hash, the same content uploaded with different tags gives different files
+func UploadWalletFile(ctx context.Context, path, fileName, encryptionTag string, desiredTier uint32, allowHigherTier bool) (string, error) {
+	if !setting.CheckLogin() {
+		return "", errors.New("please login first")
+	}
+	return uploadFileAndWait(ctx, path, fileName, encryptionTag, nil, desiredTier, allowHigherTier, nil)
+}
+
+// DownloadWalletFile downloads a file of the node wallet to the target path, and blocks until the download is over. The
+// encryption tag is the one given when the file was uploaded
+func DownloadWalletFile(ctx context.Context, fileHash, encryptionTag, targetPath string) error {
+	if !setting.CheckLogin() {
+		return errors.New("please login first")
+	}
+	handle := fwtypes.DataMeshId{Owner: setting.WalletAddress, Hash: fileHash}.String()
+	return downloadFileAndWait(ctx, handle, fileHash, encryptionTag, targetPath)
+}
+
+// DeleteWalletFile deletes a file of the node wallet, and waits for the result from the SP
+func DeleteWalletFile(ctx context.Context, fileHash string) error {
+	if !setting.CheckLogin() {
+		return errors.New("please login first")
+	}
+	nowSec := time.Now().Unix()
+	wsign, err := setting.WalletPrivateKey.Sign([]byte(msgutils.DeleteShareWalletSignMessage(fileHash, setting.WalletAddress, nowSec)))
+	if err != nil {
+		return errors.New("wallet failed to sign message")
+	}
+
+	result := file.SubscribeFileDeleteResult(fileHash)
+	defer file.UnsubscribeFileDeleteResult(fileHash)
+	DeleteFile(ctx, fileHash, setting.WalletAddress, setting.WalletPublicKey.Bytes(), wsign, nowSec)
+
+	select {
+	case <-time.After(walletFileTimeout):
+		return errors.New("timeout waiting for the file to be deleted")
+	case res := <-result:
+		if res == nil {
+			return errors.New("failed deleting the file")
+		}
+		if res.Return != rpc.SUCCESS {
+			return errors.Errorf("failed deleting the file, %v", res.Return)
+		}
+		return nil
+	}
+}
+
+// ListWalletFiles lists all the files of the node wallet matching the keyword
+func ListWalletFiles(ctx context.Context, keyword string) ([]rpc.FileInfo, error) {
+	if !setting.CheckLogin() {
+		return nil, errors.New("please login first")
+	}
+	var files []rpc.FileInfo
+	for page := uint64(0); ; page++ {
+		reqId := uuid.New().String()
+		reqCtx := core.RegisterRemoteReqId(ctx, reqId)
+		nowSec := time.Now().Unix()
+		wsign, err := setting.WalletPrivateKey.Sign([]byte(msgutils.FindMyFileListWalletSignMessage(setting.WalletAddress, nowSec)))
+		if err != nil {
+			return nil, errors.New("wallet failed to sign message")
+		}
+		FindFileList(reqCtx, "", setting.WalletAddress, page, keyword, 0, true, setting.WalletPublicKey.Bytes(), wsign, nowSec)
+
+		result, err := waitFileListResult(setting.WalletAddress + reqId)
+		if err != nil {
+			return nil, err
+		}
+		if result.Return != rpc.SUCCESS {
+			return nil, errors.Errorf("failed listing the files of the wallet, %v", result.Return)
+		}
+		files = append(files, result.FileInfo...)
+		if len(result.FileInfo) == 0 || uint64(len(files)) >= result.TotalNumber {
+			return files, nil
+		}
+	}
+}
+
+func waitFileListResult(key string) (*rpc.FileListResult, error) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(walletFileTimeout)
+	for {
+		select {
+		case <-timeout:
+			return nil, errors.New("timeout waiting for the file list")
+		case <-ticker.C:
+			if result, found := file.GetFileListResult(key); found && result != nil {
+				return result, nil
+			}
+		}
+	}
+}
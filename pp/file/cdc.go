@@ -0,0 +1,124 @@
+package file
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/bits"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+// gearTable maps each byte to a random value for the rolling hash of the chunker. It is derived from a hash, so all
+// the nodes cut the same content at the same boundaries
+var gearTable = func() (table [256]uint64) {
+	for i := range table {
+		sum := sha256.Sum256([]byte{byte(i)})
+		table[i] = binary.BigEndian.Uint64(sum[:8])
+	}
+	return
+}()
+
+// Chunker finds content defined slice boundaries with FastCDC. A boundary only depends on the bytes before it, so an
+// edit in a file only changes the slices around it
+type Chunker struct {
+	minSize int
+	avgSize int
+	maxSize int
+	// maskS is used before the average size, it has more bits than maskL so a boundary is less likely to be found
+	maskS uint64
+	maskL uint64
+}
+
+func NewChunker(minSize, avgSize, maxSize uint64) (*Chunker, error) {
+	if minSize == 0 || minSize >= avgSize || avgSize >= maxSize {
+		return nil, errors.New("slice sizes should be 0 < min < avg < max")
+	}
+	// the most significant bits of the hash depend on the most bytes
+	avgBits := bits.Len64(avgSize) - 1
+	return &Chunker{
+		minSize: int(minSize),
+		avgSize: int(avgSize),
+		maxSize: int(maxSize),
+		maskS:   ^uint64(0) << (64 - avgBits - 1),
+		maskL:   ^uint64(0) << (64 - avgBits + 1),
+	}, nil
+}
+
+// Cut returns the size of the first slice of data. The data should hold at least maxSize bytes, unless it is the end
+// of the file
+func (c *Chunker) Cut(data []byte) int {
+	n := len(data)
+	if n <= c.minSize {
+		return n
+	}
+	if n > c.maxSize {
+		n = c.maxSize
+	}
+	normalSize := c.avgSize
+	if normalSize > n {
+		normalSize = n
+	}
+
+	var hash uint64
+	i := c.minSize
+	for ; i < normalSize; i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+		if hash&c.maskS == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+		if hash&c.maskL == 0 {
+			return i + 1
+		}
+	}
+	return n
+}
+
+// SplitContentDefined returns the offsets of the slices of a file, cut at content defined boundaries
+func SplitContentDefined(filePath string, chunker *Chunker) ([]*protos.SliceOffset, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening file")
+	}
+	defer f.Close()
+
+	var offsets []*protos.SliceOffset
+	buffer := make([]byte, chunker.maxSize)
+	buffered := 0
+	offset := uint64(0)
+	for {
+		n, err := io.ReadFull(f, buffer[buffered:])
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, errors.Wrap(err, "failed reading file")
+		}
+		buffered += n
+		if buffered == 0 {
+			return offsets, nil
+		}
+
+		size := chunker.Cut(buffer[:buffered])
+		offsets = append(offsets, &protos.SliceOffset{
+			SliceOffsetStart: offset,
+			SliceOffsetEnd:   offset + uint64(size),
+		})
+		copy(buffer, buffer[size:buffered])
+		buffered -= size
+		offset += uint64(size)
+	}
+}
+
+// CalcSliceHashLike calculates the hash of the slice data the way the expected slice hash was calculated: from the data
+// only for a slice cut at content defined boundaries, from the position of the slice in the file otherwise
+func CalcSliceHashLike(expectedHash string, data []byte, fileHash string, sliceNumber uint64) (string, error) {
+	if crypto.IsContentSliceHash(expectedHash) {
+		return crypto.CalcContentSliceHash(data)
+	}
+	return crypto.CalcSliceHash(data, fileHash, sliceNumber)
+}
@@ -0,0 +1,91 @@
+package file
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/crypto"
+)
+
+func TestSplitContentDefined(t *testing.T) {
+	chunker, err := NewChunker(2*1024, 8*1024, 32*1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, 1024*1024)
+	rand.New(rand.NewSource(1)).Read(data)
+	// the same content, after a small insertion at the beginning of the file
+	edited := append([]byte("an edit"), data...)
+
+	dir := t.TempDir()
+	slices := func(content []byte) map[string]bool {
+		path := filepath.Join(dir, "file")
+		if err := os.WriteFile(path, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+		offsets, err := SplitContentDefined(path, chunker)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result := make(map[string]bool)
+		end := uint64(0)
+		for _, offset := range offsets {
+			size := offset.SliceOffsetEnd - offset.SliceOffsetStart
+			if offset.SliceOffsetStart != end || size == 0 || size > 32*1024 {
+				t.Fatal("wrong slice offset", offset)
+			}
+			end = offset.SliceOffsetEnd
+			result[string(content[offset.SliceOffsetStart:offset.SliceOffsetEnd])] = true
+		}
+		if end != uint64(len(content)) {
+			t.Fatal("the slices don't cover the file")
+		}
+		return result
+	}
+
+	original := slices(data)
+	shared := 0
+	for slice := range slices(edited) {
+		if original[slice] {
+			shared++
+		}
+	}
+	if shared < len(original)-2 {
+		t.Fatalf("only %v of %v slices are unchanged after the edit", shared, len(original))
+	}
+}
+
+func TestCalcSliceHashLike(t *testing.T) {
+	data := []byte("slice data")
+	filePath := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	fileHash, err := crypto.CalcFileHash(filePath, "", crypto.SDS_CODEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	positionHash, err := crypto.CalcSliceHash(data, fileHash, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contentHash, err := crypto.CalcContentSliceHash(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.IsContentSliceHash(positionHash) || !crypto.IsContentSliceHash(contentHash) {
+		t.Fatal("the slice hashes should be told apart by their codec")
+	}
+
+	if hash, err := CalcSliceHashLike(positionHash, data, fileHash, 1); err != nil || hash != positionHash {
+		t.Fatal("wrong hash of a slice at a position of a file", hash, err)
+	}
+	if hash, err := CalcSliceHashLike(positionHash, data, fileHash, 2); err != nil || hash == positionHash {
+		t.Fatal("a slice at another position shouldn't match", hash, err)
+	}
+	if hash, err := CalcSliceHashLike(contentHash, data, "", 0); err != nil || hash != contentHash {
+		t.Fatal("wrong hash of a slice cut at content defined boundaries", hash, err)
+	}
+}
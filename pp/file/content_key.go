@@ -0,0 +1,159 @@
+package file
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/framework/crypto/encryption"
+	"github.com/stratosnet/sds/framework/crypto/encryption/hdkey"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+// CONTENT_KEY_TAG_PREFIX marks the encryption tag of the files encrypted with a content key, instead of keys derived
+// from the wallet of the uploader
+const CONTENT_KEY_TAG_PREFIX = "ck-"
+
+// IsContentKeyEncrypted whether the file with this encryption tag is encrypted with a content key
+func IsContentKeyEncrypted(encryptionTag string) bool {
+	return strings.HasPrefix(encryptionTag, CONTENT_KEY_TAG_PREFIX)
+}
+
+// GetWalletEncryptionKey the Ed25519 key of the wallet receiving the content keys of shared files. It is derived from
+// the wallet private key, so it doesn't need to be stored
+func GetWalletEncryptionKey() (ed25519.PrivateKey, error) {
+	if setting.WalletPrivateKey == nil {
+		return nil, errors.New("wallet is not loaded")
+	}
+	seed := hdkey.MasterKeyGenerate(setting.WalletPrivateKey.Bytes(), hdkey.ED25519CurvePhrase).PrivateKey()
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// GetWalletEncryptionPublicKey the public key given to the owners of encrypted files, to share them with this wallet
+func GetWalletEncryptionPublicKey() (ed25519.PublicKey, error) {
+	privateKey, err := GetWalletEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	return privateKey.Public().(ed25519.PublicKey), nil
+}
+
+func getContentKeyPath(fileHash string) (string, error) {
+	if fileHash == "" || filepath.Base(fileHash) != fileHash {
+		return "", errors.New("invalid file hash")
+	}
+	return filepath.Join(GetContentKeyFolderPath(), fileHash), nil
+}
+
+// WrapContentKeyForWallet wraps the content key for the wallet of this node. The wrapped key of an uploaded file is
+// stored with the file by the SP, so the key can be recovered by the wallet on any node
+func WrapContentKeyForWallet(contentKey []byte) ([]byte, error) {
+	publicKey, err := GetWalletEncryptionPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := encryption.WrapContentKey(contentKey, publicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed wrapping content key")
+	}
+	return wrappedKey, nil
+}
+
+// SaveContentKey keeps the content key of a file, wrapped for the wallet of this node
+func SaveContentKey(fileHash string, contentKey []byte) error {
+	wrappedKey, err := WrapContentKeyForWallet(contentKey)
+	if err != nil {
+		return err
+	}
+	return saveWrappedKeyFile(fileHash, wrappedKey)
+}
+
+func saveWrappedKeyFile(fileHash string, wrappedKey []byte) error {
+	keyPath, err := getContentKeyPath(fileHash)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(GetContentKeyFolderPath(), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating dir")
+	}
+	if err = os.WriteFile(keyPath, []byte(base64.RawURLEncoding.EncodeToString(wrappedKey)), 0600); err != nil {
+		return errors.Wrap(err, "failed writing content key")
+	}
+	return nil
+}
+
+// SaveWrappedContentKey keeps the content key of a file, received wrapped for the wallet of this node
+func SaveWrappedContentKey(fileHash string, wrappedKey []byte) error {
+	privateKey, err := GetWalletEncryptionKey()
+	if err != nil {
+		return err
+	}
+	if _, err = encryption.UnwrapContentKey(wrappedKey, privateKey); err != nil {
+		return err
+	}
+	return saveWrappedKeyFile(fileHash, wrappedKey)
+}
+
+// RecoverContentKey the content key of a file. When this node doesn't know it, it is unwrapped from the key stored with
+// the file by the SP, and kept for the next downloads
+func RecoverContentKey(fileHash string, wrappedKey []byte) ([]byte, error) {
+	contentKey, err := GetContentKey(fileHash)
+	if err == nil || len(wrappedKey) == 0 {
+		return contentKey, err
+	}
+	if err = SaveWrappedContentKey(fileHash, wrappedKey); err != nil {
+		return nil, errors.Wrap(err, "failed recovering the content key stored with the file")
+	}
+	return GetContentKey(fileHash)
+}
+
+// EncodeShareKeys encodes the recipients of a share so they can be part of its share link
+func EncodeShareKeys(keys *protos.ShareKeys) (string, error) {
+	data, err := proto.Marshal(keys)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func DecodeShareKeys(encoded string) (*protos.ShareKeys, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid share keys")
+	}
+	keys := &protos.ShareKeys{}
+	if err = proto.Unmarshal(data, keys); err != nil {
+		return nil, errors.Wrap(err, "invalid share keys")
+	}
+	return keys, nil
+}
+
+// GetContentKey the content key of a file uploaded or received by this node
+func GetContentKey(fileHash string) ([]byte, error) {
+	keyPath, err := getContentKeyPath(fileHash)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(keyPath)
+	if os.IsNotExist(err) {
+		return nil, errors.Errorf("the content key of file %v is unknown", fileHash)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading content key")
+	}
+	wrappedKey, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid content key file")
+	}
+	privateKey, err := GetWalletEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	return encryption.UnwrapContentKey(wrappedKey, privateKey)
+}
@@ -0,0 +1,55 @@
+package file
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/crypto/encryption"
+	"github.com/stratosnet/sds/framework/crypto/secp256k1"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func TestRecoverContentKey(t *testing.T) {
+	privateKey, err := secp256k1.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setting.WalletPrivateKey = privateKey
+	setting.WalletPublicKey = privateKey.PubKey()
+	setting.SetupRoot(t.TempDir())
+
+	contentKey, err := encryption.GenerateContentKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrappedKey, err := WrapContentKeyForWallet(contentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = RecoverContentKey("file1", nil); err == nil {
+		t.Fatal("an unknown content key without the key stored with the file shouldn't be recovered")
+	}
+
+	// the local keys are lost, e.g. the node home was recreated
+	if err = os.RemoveAll(GetContentKeyFolderPath()); err != nil {
+		t.Fatal(err)
+	}
+	recovered, err := RecoverContentKey("file1", wrappedKey)
+	if err != nil || !bytes.Equal(recovered, contentKey) {
+		t.Fatal("the content key should be recovered from the key stored with the file", err)
+	}
+	if recovered, err = GetContentKey("file1"); err != nil || !bytes.Equal(recovered, contentKey) {
+		t.Fatal("the recovered content key should be kept", err)
+	}
+
+	otherKey, err := secp256k1.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setting.WalletPrivateKey = otherKey
+	setting.WalletPublicKey = otherKey.PubKey()
+	if _, err = RecoverContentKey("file2", wrappedKey); err == nil {
+		t.Fatal("another wallet shouldn't recover the content key")
+	}
+}
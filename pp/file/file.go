@@ -36,12 +36,16 @@ var (
 
 	// key(fileHash) : value(file path)
 	fileMap           = make(map[string]string)
+	fileMapMutex      sync.RWMutex
 	infoMutex         sync.Mutex
 	DataBuffer        sync.Mutex
 	fileInfoMap       = utils.NewAutoCleanMap(1 * time.Hour)
 	downloadMap       = utils.NewAutoCleanMap(1 * time.Hour)
 	downloadSliceChan = &sync.Map{}
 	downloadShareChan = &sync.Map{}
+
+	// key(fileHash) : value(file path), where a local download is saved instead of the download folder
+	downloadTargetMap = &sync.Map{}
 )
 
 type DownloadSlice struct {
@@ -106,7 +110,7 @@ func GetFileHash(filePath, encryptionTag string) string {
 		utils.ErrorLog(err)
 	}
 	utils.DebugLog("filehash", filehash)
-	fileMap[filehash] = filePath
+	setFilePath(filehash, filePath)
 	return filehash
 }
 
@@ -116,15 +120,25 @@ func GetFileHashForVideoStream(filePath, encryptionTag string) string {
 		utils.ErrorLog(err)
 	}
 	utils.DebugLog("filehash", filehash)
-	fileMap[filehash] = filePath
+	setFilePath(filehash, filePath)
 	return filehash
 }
 
 func GetFilePath(hash string) string {
+	fileMapMutex.RLock()
+	defer fileMapMutex.RUnlock()
 	return fileMap[hash]
 }
 
+func setFilePath(hash, filePath string) {
+	fileMapMutex.Lock()
+	defer fileMapMutex.Unlock()
+	fileMap[hash] = filePath
+}
+
 func ClearFileMap(hash string) {
+	fileMapMutex.Lock()
+	defer fileMapMutex.Unlock()
 	delete(fileMap, hash)
 }
 
@@ -155,17 +169,19 @@ func ReadFileDataToPackets(r *mmap.ReaderAt, path string) (size int64, buffer []
 	if err != nil {
 		return
 	}
-	size = info.Size()
-	buffer = RequestBuffersForSlice(size)
+	return readDataToPackets(r, info.Size())
+}
+
+func readDataToPackets(r io.ReaderAt, size int64) (int64, [][]byte, error) {
+	buffer := RequestBuffersForSlice(size)
 
 	var i int64
 	for i = 0; i*setting.MaxData < size; i++ {
-		_, err = r.ReadAt(buffer[i], i*setting.MaxData)
-		if err != nil {
-			return
+		if _, err := r.ReadAt(buffer[i], i*setting.MaxData); err != nil && err != io.EOF {
+			return size, buffer, err
 		}
 	}
-	return
+	return size, buffer, nil
 }
 
 func ReadSliceDataFromTmp(fileHash, sliceHash string) (int64, [][]byte, error) {
@@ -183,29 +199,19 @@ func GetSliceDataFromTmp(fileHash, sliceHash string) ([]byte, error) {
 }
 
 func ReadSliceData(fileHash, sliceHash string) (int64, [][]byte, error) {
-
-	slicePath, err := getSlicePath(sliceHash)
+	r, err := GetSliceStore().OpenSlice(sliceHash)
 	if err != nil {
-		return 0, nil, err
+		return ReadSliceDataFromTmp(fileHash, sliceHash)
 	}
-	r, err := mmap.Open(slicePath)
-	if err != nil {
-		slicePath = GetTmpSlicePath(fileHash, sliceHash)
-		r, err = mmap.Open(slicePath)
-		if err != nil {
-			return 0, nil, err
-		}
-	}
-
-	return ReadFileDataToPackets(r, slicePath)
+	defer func() {
+		_ = r.Close()
+	}()
+	return readDataToPackets(r, r.Size())
 }
 
+// GetSliceData reads a committed slice, the slice store does its own locking
 func GetSliceData(sliceHash string) ([]byte, error) {
-	slicePath, err := getSlicePath(sliceHash)
-	if err != nil {
-		return nil, err
-	}
-	return GetWholeFileData(slicePath)
+	return GetSliceStore().ReadSlice(sliceHash)
 }
 
 func GetWholeFileData(filePath string) ([]byte, error) {
@@ -219,15 +225,11 @@ func GetWholeFileData(filePath string) ([]byte, error) {
 }
 
 func GetSliceSize(sliceHash string) (int64, error) {
-	slicePath, err := getSlicePath(sliceHash)
-	if err != nil {
-		return 0, errors.Wrap(err, "failed getting slice path")
-	}
-	info, err := GetFileInfo(slicePath)
+	size, err := GetSliceStore().SliceSize(sliceHash)
 	if err != nil {
-		return 0, errors.Wrap(err, "failed getting file info")
+		return 0, errors.Wrap(err, "failed getting slice size")
 	}
-	return info.Size(), nil
+	return size, nil
 }
 func OpenTmpFile(fileHash, fileName string) (*os.File, error) {
 	tmpFileFolderPath := GetTmpFileFolderPath(fileHash)
@@ -277,25 +279,22 @@ func SaveTmpSliceData(fileHash, sliceHash string, data []byte) error {
 func SaveSliceData(data []byte, sliceHash string, offset uint64) error {
 	wmutex.Lock()
 	defer wmutex.Unlock()
-	slicePath, err := getSlicePath(sliceHash)
-	if err != nil {
-		return errors.Wrap(err, "failed getting slice path")
-	}
-	fileMg, err := os.OpenFile(slicePath, os.O_CREATE|os.O_RDWR, 0600)
-	defer func() {
-		_ = fileMg.Close()
-	}()
-	if err != nil {
-		return errors.Wrap(err, "failed opening a file")
-	}
-	_, err = fileMg.WriteAt(data, int64(offset))
-	if err != nil {
+	if err := GetSliceStore().WriteSlice(sliceHash, data, offset); err != nil {
 		utils.ErrorLog("error save file")
-		return errors.Wrap(err, "failed writing data")
+		return err
 	}
 	return nil
 }
 
+// CommitSlice moves a slice to its final location in the slice store, once it was fully received and verified.
+// The file hash and slice number are kept so that the slice can be verified again later
+func CommitSlice(sliceHash, fileHash string, sliceNumber uint64) error {
+	if err := GetSliceStore().CommitSlice(sliceHash); err != nil {
+		return err
+	}
+	return SaveSliceMeta(sliceHash, fileHash, sliceNumber)
+}
+
 func WriteFile(data []byte, offset int64, fileMg *os.File) error {
 	_, err := fileMg.Seek(offset, 0)
 	if err != nil {
@@ -408,7 +407,7 @@ func CheckFileExisting(ctx context.Context, fileHash, fileName, savePath, encryp
 	if IsFileRpcRemote(fileHash + fileReqId) {
 		return false
 	}
-	filePath := GetDownloadFilePath(fileName, savePath)
+	filePath := getLocalDownloadFilePath(fileHash, fileName, savePath)
 	utils.DebugLog("filePath", filePath)
 	file, err := os.OpenFile(filePath, os.O_RDONLY, 0600)
 	defer func() {
@@ -460,11 +459,33 @@ func copyFile(srcPath, dstPath string) (int64, error) {
 	return nBytes, err
 }
 
+// CopyFile copies a regular file, the folder of the destination is created if needed
+func CopyFile(srcPath, dstPath string) error {
+	_, err := copyFile(srcPath, dstPath)
+	return err
+}
+
 func CopyDownloadFile(fileHash, fileName, savePath string) error {
-	_, err := copyFile(GetDownloadTmpFilePath(fileHash, fileName), GetDownloadFilePath(fileName, ""))
+	_, err := copyFile(GetDownloadTmpFilePath(fileHash, fileName), getLocalDownloadFilePath(fileHash, fileName, ""))
 	return err
 }
 
+// SetDownloadTargetPath makes the next local download of the file to be saved to the given path
+func SetDownloadTargetPath(fileHash, targetPath string) {
+	downloadTargetMap.Store(fileHash, targetPath)
+}
+
+func DeleteDownloadTargetPath(fileHash string) {
+	downloadTargetMap.Delete(fileHash)
+}
+
+func getLocalDownloadFilePath(fileHash, fileName, savePath string) string {
+	if targetPath, ok := downloadTargetMap.Load(fileHash); ok {
+		return targetPath.(string)
+	}
+	return GetDownloadFilePath(fileName, savePath)
+}
+
 func CheckSliceExisting(fileHash, fileName, sliceHash, fileReqId string) bool {
 	utils.DebugLog("CheckSliceExisting sliceHash", sliceHash)
 
@@ -498,14 +519,10 @@ func CheckSliceExisting(fileHash, fileName, sliceHash, fileReqId string) bool {
 }
 
 func DeleteSlice(sliceHash string) error {
-	slicePath, err := getSlicePath(sliceHash)
-	if err != nil {
-		return errors.Wrap(err, "failed getting slice path")
-	}
-	if err := os.Remove(slicePath); err != nil {
+	if err := GetSliceStore().DeleteSlice(sliceHash); err != nil {
 		return errors.Wrap(err, "failed removing slice")
 	}
-	return nil
+	return deleteSliceMeta(sliceHash)
 }
 
 func DeleteDirectory(fileHash string) {
@@ -63,6 +63,15 @@ var (
 	// key(filehash) : value(*rpc.ParamUploadSign)
 	rpcFileUploadSign = &sync.Map{}
 
+	// key(reqid) : value(*rpc.DirectoryUploadResult)
+	rpcDirectoryUploadResult = utils.NewAutoCleanMap(24 * time.Hour)
+
+	// key(reqid) : value(*rpc.DirectoryDownloadResult)
+	rpcDirectoryDownloadResult = utils.NewAutoCleanMap(24 * time.Hour)
+
+	// key(reqid) : value(*rpc.ObjectVersionResult)
+	rpcObjectVersionResult = utils.NewAutoCleanMap(24 * time.Hour)
+
 	// wait for the next request from client per message
 	RpcWaitTimeout time.Duration
 )
@@ -73,7 +82,7 @@ type DataWithOffset struct {
 }
 
 func IsFileRpcRemote(key string) bool {
-	str := fileMap[key]
+	str := GetFilePath(key)
 	if str == "" {
 		return false
 	}
@@ -233,7 +242,7 @@ func SaveRemoteFileHash(hash, filePath string, fileSize uint64) {
 	reFileMutex.Lock()
 	defer reFileMutex.Unlock()
 
-	fileMap[hash] = "rpc:" + filePath
+	setFilePath(hash, "rpc:"+filePath)
 	rpcFileInfoMap.Store(hash, fileSize)
 }
 
@@ -497,6 +506,34 @@ func SetQueryOzoneResult(key string, result *rpc.GetOzoneResult) {
 	}
 }
 
+func GetDirectoryUploadResult(key string) (*rpc.DirectoryUploadResult, bool) {
+	result, loaded := rpcDirectoryUploadResult.Load(key)
+	if result != nil && loaded {
+		return result.(*rpc.DirectoryUploadResult), loaded
+	}
+	return nil, loaded
+}
+
+func SetDirectoryUploadResult(key string, result *rpc.DirectoryUploadResult) {
+	if result != nil {
+		rpcDirectoryUploadResult.Store(key, result)
+	}
+}
+
+func GetDirectoryDownloadResult(key string) (*rpc.DirectoryDownloadResult, bool) {
+	result, loaded := rpcDirectoryDownloadResult.Load(key)
+	if result != nil && loaded {
+		return result.(*rpc.DirectoryDownloadResult), loaded
+	}
+	return nil, loaded
+}
+
+func SetDirectoryDownloadResult(key string, result *rpc.DirectoryDownloadResult) {
+	if result != nil {
+		rpcDirectoryDownloadResult.Store(key, result)
+	}
+}
+
 func SubscribeFileShareResult(shareLink string) chan *rpc.FileShareResult {
 	event := make(chan *rpc.FileShareResult)
 	downloadShareChan.Store(shareLink, event)
@@ -547,3 +584,17 @@ func SetFileUploadSign(sig *rpc.ParamUploadSign, fileHash string) {
 	}
 	v.(chan *rpc.ParamUploadSign) <- sig
 }
+
+func GetObjectVersionResult(key string) (*rpc.ObjectVersionResult, bool) {
+	result, loaded := rpcObjectVersionResult.Load(key)
+	if result != nil && loaded {
+		return result.(*rpc.ObjectVersionResult), loaded
+	}
+	return nil, loaded
+}
+
+func SetObjectVersionResult(key string, result *rpc.ObjectVersionResult) {
+	if result != nil {
+		rpcObjectVersionResult.Store(key, result)
+	}
+}
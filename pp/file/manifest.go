@@ -0,0 +1,107 @@
+package file
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DIRECTORY_MANIFEST_VERSION = 1
+	DIRECTORY_MANIFEST_EXT     = ".sdsdir.json"
+	MANIFEST_FOLDER            = "manifest"
+)
+
+// DirectoryManifest describes an uploaded directory. Files maps the path of each file, relative to the root of the
+// directory and separated by "/", to the sdm:// file handle of the uploaded file
+type DirectoryManifest struct {
+	Version int               `json:"version"`
+	Name    string            `json:"name"`
+	Files   map[string]string `json:"files"`
+	// EncryptionTags file handle -> encryption tag of the encrypted files, needed to check the hash of a restored file
+	EncryptionTags map[string]string `json:"encryption_tags,omitempty"`
+}
+
+// GetManifestFolderPath path to the folder where the manifests of uploaded directories are written before their upload
+func GetManifestFolderPath() string {
+	return filepath.Join(getTmpFolderPath(), MANIFEST_FOLDER)
+}
+
+// IsDirectoryManifest checks if the file name is the name of a directory manifest
+func IsDirectoryManifest(fileName string) bool {
+	return strings.HasSuffix(fileName, DIRECTORY_MANIFEST_EXT)
+}
+
+// ListDirectoryFiles walks the directory tree and returns the relative path of every regular file in it.
+// Symbolic links and other special files are skipped
+func ListDirectoryFiles(dirPath string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dirPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		relPath, err := filepath.Rel(dirPath, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(relPath))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed walking the directory")
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// SaveDirectoryManifest writes the manifest in the manifest folder, and returns the path of the written file
+func SaveDirectoryManifest(manifest *DirectoryManifest, id string) (string, error) {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "failed encoding the manifest")
+	}
+	folder := filepath.Join(GetManifestFolderPath(), id)
+	if err = os.MkdirAll(folder, os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "failed creating dir")
+	}
+	manifestPath := filepath.Join(folder, manifest.Name+DIRECTORY_MANIFEST_EXT)
+	if err = os.WriteFile(manifestPath, data, 0600); err != nil {
+		return "", errors.Wrap(err, "failed writing the manifest")
+	}
+	return manifestPath, nil
+}
+
+// LoadDirectoryManifest reads and validates a manifest file
+func LoadDirectoryManifest(manifestPath string) (*DirectoryManifest, error) {
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading the manifest")
+	}
+	manifest := &DirectoryManifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrap(err, "failed decoding the manifest")
+	}
+	if manifest.Version != DIRECTORY_MANIFEST_VERSION {
+		return nil, errors.Errorf("unsupported manifest version %v", manifest.Version)
+	}
+	if manifest.Name == "" || manifest.Name == "." || manifest.Name == ".." || strings.ContainsAny(manifest.Name, `/\`) {
+		return nil, errors.Errorf("invalid directory name %v in the manifest", manifest.Name)
+	}
+	for relPath := range manifest.Files {
+		// never let a manifest write outside the directory it is restored to
+		cleaned := path.Clean(relPath)
+		if path.IsAbs(cleaned) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") || strings.Contains(relPath, `\`) {
+			return nil, errors.Errorf("invalid path %v in the manifest", relPath)
+		}
+	}
+	return manifest, nil
+}
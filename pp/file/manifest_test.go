@@ -0,0 +1,63 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func TestDirectoryManifest(t *testing.T) {
+	setting.SetupRoot(t.TempDir())
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "a", "b"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"root.txt", "a/one.txt", "a/b/two.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, filepath.FromSlash(name)), []byte(name), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := ListDirectoryFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"a/b/two.txt", "a/one.txt", "root.txt"}
+	if len(files) != len(expected) {
+		t.Fatal("expected files", expected, "got", files)
+	}
+	for i := range expected {
+		if files[i] != expected[i] {
+			t.Fatal("expected files", expected, "got", files)
+		}
+	}
+
+	manifest := &DirectoryManifest{Version: DIRECTORY_MANIFEST_VERSION, Name: "dir", Files: make(map[string]string)}
+	for _, f := range files {
+		manifest.Files[f] = "sdm://handle/" + f
+	}
+	manifestPath, err := SaveDirectoryManifest(manifest, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadDirectoryManifest(manifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Name != "dir" || len(loaded.Files) != len(files) {
+		t.Fatal("the loaded manifest doesn't match the saved one")
+	}
+
+	// a manifest must not be able to write outside of the restored directory
+	for _, relPath := range []string{"../escape.txt", "/etc/passwd", "a/../../escape.txt", "."} {
+		manifest.Files = map[string]string{relPath: "sdm://handle"}
+		if manifestPath, err = SaveDirectoryManifest(manifest, "2"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = LoadDirectoryManifest(manifestPath); err == nil {
+			t.Fatal("the manifest should be rejected for path", relPath)
+		}
+	}
+}
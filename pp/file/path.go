@@ -13,6 +13,12 @@ import (
 	"github.com/stratosnet/sds/pp/setting"
 )
 
+const (
+	UPLOAD_TASK_FOLDER = "upload_tasks"
+	CONTENT_KEY_FOLDER = "content_keys"
+	S3_GATEWAY_FOLDER  = "s3gateway"
+)
+
 // getTmpFolderPath path to the tmp file folder
 func getTmpFolderPath() string {
 	return filepath.Join(setting.GetRootPath(), TEMP_FOLDER)
@@ -58,30 +64,27 @@ func GetDownloadFileNameFromTmp(fileHash string) (string, error) {
 	return "", errors.New("can't find cached files")
 }
 
+// GetUploadTaskFolderPath path to the folder keeping the state of ongoing upload tasks
+func GetUploadTaskFolderPath() string {
+	return filepath.Join(setting.GetRootPath(), UPLOAD_TASK_FOLDER)
+}
+
+// GetContentKeyFolderPath path to the folder keeping the content keys of the encrypted files known by this node. The
+// keys of the uploaded files are also stored with the files by the SP, so they are recovered when this folder is lost
+func GetContentKeyFolderPath() string {
+	return filepath.Join(setting.GetRootPath(), CONTENT_KEY_FOLDER)
+}
+
+// GetS3GatewayFolderPath path to the tmp folder holding the objects passing through the S3 gateway
+func GetS3GatewayFolderPath() string {
+	return filepath.Join(getTmpFolderPath(), S3_GATEWAY_FOLDER)
+}
+
 // GetDownloadFilePath path to a file as in download folder
 func GetDownloadFilePath(fileName, savePath string) string {
 	return filepath.Join(setting.Config.Home.DownloadPath, savePath, fileName)
 }
 
-func getSlicePath(hash string) (string, error) {
-	if len(hash) < 10 {
-		return "", errors.New("wrong size of slice hash")
-	}
-	s1 := string([]rune(hash)[:8])
-	s2 := string([]rune(hash)[8:10])
-	path := filepath.Join(setting.Config.Home.StoragePath, s1, s2)
-	exist, err := PathExists(path)
-	if err != nil {
-		return "", errors.Wrap(err, "failed checking path")
-	}
-	if !exist {
-		if err = os.MkdirAll(path, os.ModePerm); err != nil {
-			return "", errors.Wrap(err, "failed creating dir")
-		}
-	}
-	return filepath.Join(path, hash), nil
-}
-
 // pathExists
 func PathExists(path string) (bool, error) {
 	_, err := os.Stat(path)
@@ -0,0 +1,190 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const QUARANTINE_FOLDER = "quarantine"
+
+// ScrubStatus progress of the last verification of the stored slices
+type ScrubStatus struct {
+	Running     bool     `json:"running"`
+	StartTime   int64    `json:"start_time"`
+	EndTime     int64    `json:"end_time"`
+	Checked     uint64   `json:"checked"`
+	Skipped     uint64   `json:"skipped"`    // slices without meta, which can't be verified
+	Unreadable  uint64   `json:"unreadable"` // slices which couldn't be read, they are checked again next time
+	Corrupted   uint64   `json:"corrupted"`
+	BytesRead   uint64   `json:"bytes_read"`
+	LastError   string   `json:"last_error,omitempty"`
+	Quarantined []string `json:"quarantined,omitempty"`
+}
+
+var (
+	scrubStatus ScrubStatus
+	scrubStop   chan struct{}
+	scrubMutex  sync.Mutex
+)
+
+// GetQuarantinePath path to the copy of a slice which failed its integrity check
+func GetQuarantinePath(sliceHash string) string {
+	return filepath.Join(setting.Config.Home.StoragePath, QUARANTINE_FOLDER, sliceHash)
+}
+
+func GetScrubStatus() ScrubStatus {
+	scrubMutex.Lock()
+	defer scrubMutex.Unlock()
+	status := scrubStatus
+	status.Quarantined = append([]string(nil), scrubStatus.Quarantined...)
+	return status
+}
+
+// StopSliceScrub interrupts the running verification, if any
+func StopSliceScrub() bool {
+	scrubMutex.Lock()
+	defer scrubMutex.Unlock()
+	if scrubStop == nil {
+		return false
+	}
+	close(scrubStop)
+	scrubStop = nil
+	return true
+}
+
+func updateScrubStatus(fn func(status *ScrubStatus)) {
+	scrubMutex.Lock()
+	defer scrubMutex.Unlock()
+	fn(&scrubStatus)
+}
+
+// ScrubSlices reads every stored slice and verifies its hash, reading at most ioBudget bytes per second (0 for no
+// limit). The slices failing the check are moved to the quarantine folder and returned, so they can be reported
+func ScrubSlices(ioBudget uint64) ([]*protos.CorruptedSlice, error) {
+	scrubMutex.Lock()
+	if scrubStatus.Running {
+		scrubMutex.Unlock()
+		return nil, errors.New("slices are already being verified")
+	}
+	stop := make(chan struct{})
+	scrubStop = stop
+	scrubStatus = ScrubStatus{Running: true, StartTime: time.Now().Unix()}
+	scrubMutex.Unlock()
+
+	corrupted, err := scrubSlices(ioBudget, stop)
+
+	scrubMutex.Lock()
+	defer scrubMutex.Unlock()
+	if scrubStop == stop {
+		scrubStop = nil
+	}
+	scrubStatus.Running = false
+	scrubStatus.EndTime = time.Now().Unix()
+	utils.Logf("slice verification done: %v checked, %v corrupted, %v skipped without meta, %v unreadable",
+		scrubStatus.Checked, scrubStatus.Corrupted, scrubStatus.Skipped, scrubStatus.Unreadable)
+	if err != nil {
+		scrubStatus.LastError = err.Error()
+	}
+	return corrupted, err
+}
+
+func scrubSlices(ioBudget uint64, stop chan struct{}) ([]*protos.CorruptedSlice, error) {
+	store := GetSliceStore()
+	// the list is taken first, so that slices added or removed during the verification don't disturb the iteration
+	var sliceHashes []string
+	if err := store.RangeSlices(func(sliceHash string) bool {
+		sliceHashes = append(sliceHashes, sliceHash)
+		return true
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed listing slices")
+	}
+
+	var corrupted []*protos.CorruptedSlice
+	var bytesRead uint64
+	start := time.Now()
+	for _, sliceHash := range sliceHashes {
+		select {
+		case <-stop:
+			return corrupted, errors.New("verification stopped")
+		default:
+		}
+
+		meta, ok := GetSliceMeta(sliceHash)
+		if !ok {
+			updateScrubStatus(func(status *ScrubStatus) { status.Skipped++ })
+			continue
+		}
+		data, err := store.ReadSlice(sliceHash)
+		if errors.Is(err, ErrSliceNotFound) {
+			// deleted since the list was taken
+			continue
+		}
+		if err != nil {
+			// an I/O error or an unreachable store doesn't tell anything about the data, only a hash mismatch does
+			utils.ErrorLogf("failed reading slice %v, skipping its integrity check: %v", sliceHash, err.Error())
+			updateScrubStatus(func(status *ScrubStatus) { status.Unreadable++ })
+			continue
+		}
+		bytesRead += uint64(len(data))
+		updateScrubStatus(func(status *ScrubStatus) {
+			status.Checked++
+			status.BytesRead = bytesRead
+		})
+
+		hash, err := CalcSliceHashLike(sliceHash, data, meta.FileHash, meta.SliceNumber)
+		if err != nil {
+			utils.ErrorLogf("failed calculating the hash of slice %v, skipping its integrity check: %v", sliceHash, err.Error())
+			continue
+		}
+		if hash != sliceHash {
+			utils.ErrorLogf("slice %v failed its integrity check: calculated hash %v", sliceHash, hash)
+			if err = quarantineSlice(sliceHash, data); err != nil {
+				utils.ErrorLog("failed quarantining slice "+sliceHash, err.Error())
+			}
+			corrupted = append(corrupted, &protos.CorruptedSlice{
+				SliceHash:   sliceHash,
+				FileHash:    meta.FileHash,
+				SliceNumber: meta.SliceNumber,
+				SliceSize:   uint64(len(data)),
+			})
+			updateScrubStatus(func(status *ScrubStatus) {
+				status.Corrupted++
+				status.Quarantined = append(status.Quarantined, sliceHash)
+			})
+		}
+
+		if ioBudget == 0 {
+			continue
+		}
+		wait := time.Duration(float64(bytesRead)/float64(ioBudget)*float64(time.Second)) - time.Since(start)
+		if wait <= 0 {
+			continue
+		}
+		select {
+		case <-stop:
+			return corrupted, errors.New("verification stopped")
+		case <-time.After(wait):
+		}
+	}
+	return corrupted, nil
+}
+
+// quarantineSlice keeps a copy of a corrupted slice for inspection, and removes it from the slice store
+func quarantineSlice(sliceHash string, data []byte) error {
+	quarantinePath := GetQuarantinePath(sliceHash)
+	if err := os.MkdirAll(filepath.Dir(quarantinePath), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating dir")
+	}
+	if err := os.WriteFile(quarantinePath, data, 0600); err != nil {
+		return errors.Wrap(err, "failed writing quarantined slice")
+	}
+	return DeleteSlice(sliceHash)
+}
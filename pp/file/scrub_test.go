@@ -0,0 +1,98 @@
+package file
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	mbase "github.com/multiformats/go-multibase"
+	mh "github.com/multiformats/go-multihash"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func TestScrubSlices(t *testing.T) {
+	utils.NewDefaultLogger("", false, false)
+	setting.Config = setting.DefaultConfig()
+	setting.Config.Home.StoragePath = t.TempDir()
+	defer func() {
+		_ = CloseSliceStore()
+	}()
+
+	fileKeccak, _ := mh.Sum([]byte("file"), mh.KECCAK_256, 20)
+	encoder, _ := mbase.NewEncoder(mbase.Base32hex)
+	fileHash := cid.NewCidV1(uint64(crypto.SDS_CODEC), fileKeccak).Encode(encoder)
+
+	var sliceHashes []string
+	for i := uint64(0); i < 3; i++ {
+		data := bytes.Repeat([]byte{byte(i)}, 1000)
+		sliceHash, err := crypto.CalcSliceHash(data, fileHash, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = SaveSliceData(data, sliceHash, 0); err != nil {
+			t.Fatal(err)
+		}
+		if err = CommitSlice(sliceHash, fileHash, i); err != nil {
+			t.Fatal(err)
+		}
+		sliceHashes = append(sliceHashes, sliceHash)
+	}
+	// a slice stored before its meta was recorded can't be verified
+	if err := SaveSliceData([]byte("legacy"), testSliceHash, 0); err != nil {
+		t.Fatal(err)
+	}
+	// the content of a slice is damaged on disk
+	if err := SaveSliceData([]byte{0xff}, sliceHashes[1], 10); err != nil {
+		t.Fatal(err)
+	}
+
+	// a slice which can't be read isn't known to be corrupted
+	sliceStoreMutex.Lock()
+	sliceStore = &unreadableSliceStore{SliceStore: sliceStore, sliceHash: sliceHashes[2]}
+	sliceStoreMutex.Unlock()
+
+	corrupted, err := ScrubSlices(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(corrupted) != 1 || corrupted[0].SliceHash != sliceHashes[1] || corrupted[0].SliceNumber != 1 ||
+		corrupted[0].FileHash != fileHash {
+		t.Fatal("wrong corrupted slices", corrupted)
+	}
+	status := GetScrubStatus()
+	if status.Running || status.Checked != 2 || status.Skipped != 1 || status.Unreadable != 1 || status.Corrupted != 1 {
+		t.Fatal("wrong scrub status", status)
+	}
+
+	if _, err = GetSliceStore().ReadSlice(sliceHashes[1]); err != ErrSliceNotFound {
+		t.Fatal("the corrupted slice should be removed from the store", err)
+	}
+	if exist, _ := PathExists(GetQuarantinePath(sliceHashes[1])); !exist {
+		t.Fatal("the corrupted slice should be quarantined")
+	}
+	if _, ok := GetSliceMeta(sliceHashes[1]); ok {
+		t.Fatal("the meta of the corrupted slice should be removed")
+	}
+	if _, err = GetSliceStore().ReadSlice(sliceHashes[0]); err != nil {
+		t.Fatal("the valid slices should be kept", err)
+	}
+	if _, ok := GetSliceMeta(sliceHashes[2]); !ok {
+		t.Fatal("the unreadable slice should be kept")
+	}
+}
+
+type unreadableSliceStore struct {
+	SliceStore
+	sliceHash string
+}
+
+func (s *unreadableSliceStore) ReadSlice(sliceHash string) ([]byte, error) {
+	if sliceHash == s.sliceHash {
+		return nil, errors.New("i/o timeout")
+	}
+	return s.SliceStore.ReadSlice(sliceHash)
+}
@@ -0,0 +1,190 @@
+package file
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	SLICE_META_FILE = "slices.meta"
+
+	// the meta log is rewritten when it holds this many more records than stored slices
+	sliceMetaMaxGarbage = 10000
+)
+
+// SliceMeta what is needed to verify the hash of a stored slice
+type SliceMeta struct {
+	FileHash    string
+	SliceNumber uint64
+}
+
+var (
+	// key(sliceHash) : value(SliceMeta), loaded from an append-only log in the storage folder
+	sliceMetas       map[string]SliceMeta
+	sliceMetaLog     *os.File
+	sliceMetaRecords int
+	sliceMetaMutex   sync.Mutex
+)
+
+func getSliceMetaPath() string {
+	return filepath.Join(setting.Config.Home.StoragePath, SLICE_META_FILE)
+}
+
+// loadSliceMetas replays the meta log. The caller holds the lock
+func loadSliceMetas() error {
+	if sliceMetas != nil {
+		return nil
+	}
+	if err := os.MkdirAll(setting.Config.Home.StoragePath, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating dir")
+	}
+	f, err := os.OpenFile(getSliceMetaPath(), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening slice meta log")
+	}
+
+	metas := make(map[string]SliceMeta)
+	records := 0
+	var validSize int64
+	reader := bufio.NewReader(f)
+	for {
+		// a line without its end was torn by a crash, it is dropped
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			break
+		}
+		validSize += int64(len(line))
+		fields := strings.Fields(line)
+		records++
+		switch {
+		case len(fields) == 2 && fields[1] == "-":
+			delete(metas, fields[0])
+		case len(fields) == 3:
+			sliceNumber, err := strconv.ParseUint(fields[2], 10, 64)
+			if err != nil {
+				continue
+			}
+			metas[fields[0]] = SliceMeta{FileHash: fields[1], SliceNumber: sliceNumber}
+		}
+	}
+	if err = f.Truncate(validSize); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed truncating slice meta log")
+	}
+	if _, err = f.Seek(validSize, io.SeekStart); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed seeking in slice meta log")
+	}
+	sliceMetas, sliceMetaLog, sliceMetaRecords = metas, f, records
+
+	if sliceMetaRecords > len(sliceMetas)+sliceMetaMaxGarbage {
+		if err = rewriteSliceMetas(); err != nil {
+			utils.ErrorLog("failed rewriting slice meta log", err.Error())
+		}
+	}
+	return nil
+}
+
+// rewriteSliceMetas replaces the meta log by one holding only the stored slices. The caller holds the lock
+func rewriteSliceMetas() error {
+	tmpPath := getSliceMetaPath() + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(f)
+	for sliceHash, meta := range sliceMetas {
+		if _, err = fmt.Fprintf(writer, "%v %v %v\n", sliceHash, meta.FileHash, meta.SliceNumber); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, getSliceMetaPath())
+	}
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	_ = sliceMetaLog.Close()
+	sliceMetaLog = f
+	sliceMetaRecords = len(sliceMetas)
+	return nil
+}
+
+func appendSliceMetaRecord(record string) error {
+	if _, err := sliceMetaLog.WriteString(record); err != nil {
+		return errors.Wrap(err, "failed writing slice meta log")
+	}
+	sliceMetaRecords++
+	return nil
+}
+
+// SaveSliceMeta records which file a stored slice belongs to
+func SaveSliceMeta(sliceHash, fileHash string, sliceNumber uint64) error {
+	sliceMetaMutex.Lock()
+	defer sliceMetaMutex.Unlock()
+	if err := loadSliceMetas(); err != nil {
+		return err
+	}
+	meta := SliceMeta{FileHash: fileHash, SliceNumber: sliceNumber}
+	if old, ok := sliceMetas[sliceHash]; ok && old == meta {
+		return nil
+	}
+	if err := appendSliceMetaRecord(fmt.Sprintf("%v %v %v\n", sliceHash, fileHash, sliceNumber)); err != nil {
+		return err
+	}
+	sliceMetas[sliceHash] = meta
+	return nil
+}
+
+// GetSliceMeta slices stored before their meta was recorded have none
+func GetSliceMeta(sliceHash string) (SliceMeta, bool) {
+	sliceMetaMutex.Lock()
+	defer sliceMetaMutex.Unlock()
+	if err := loadSliceMetas(); err != nil {
+		utils.ErrorLog("failed loading slice metas", err.Error())
+		return SliceMeta{}, false
+	}
+	meta, ok := sliceMetas[sliceHash]
+	return meta, ok
+}
+
+func deleteSliceMeta(sliceHash string) error {
+	sliceMetaMutex.Lock()
+	defer sliceMetaMutex.Unlock()
+	if err := loadSliceMetas(); err != nil {
+		return err
+	}
+	if _, ok := sliceMetas[sliceHash]; !ok {
+		return nil
+	}
+	if err := appendSliceMetaRecord(fmt.Sprintf("%v -\n", sliceHash)); err != nil {
+		return err
+	}
+	delete(sliceMetas, sliceHash)
+	return nil
+}
+
+func closeSliceMetas() {
+	sliceMetaMutex.Lock()
+	defer sliceMetaMutex.Unlock()
+	if sliceMetaLog != nil {
+		_ = sliceMetaLog.Close()
+	}
+	sliceMetas, sliceMetaLog, sliceMetaRecords = nil, nil, 0
+}
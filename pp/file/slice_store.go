@@ -0,0 +1,320 @@
+package file
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	SLICE_STORE_FS   = "fs"
+	SLICE_STORE_PACK = "pack"
+	SLICE_STORE_S3   = "s3"
+
+	STAGING_FOLDER = "staging"
+)
+
+var (
+	ErrSliceNotFound = errors.New("slice not found")
+
+	sliceStore      SliceStore
+	sliceStoreMutex sync.RWMutex
+)
+
+// SliceStore persists the slices stored by this node. A slice is written piece by piece, and stays readable while it is
+// incomplete so that its size and hash can be checked. Once verified, the slice is committed to its final location
+type SliceStore interface {
+	// WriteSlice writes a piece of a slice at the given offset
+	WriteSlice(sliceHash string, data []byte, offset uint64) error
+	// CommitSlice is called once the whole slice was received and its hash verified
+	CommitSlice(sliceHash string) error
+	// OpenSlice gives random access to the content of a slice. The reader must be closed after use
+	OpenSlice(sliceHash string) (SliceReader, error)
+	ReadSlice(sliceHash string) ([]byte, error)
+	SliceSize(sliceHash string) (int64, error)
+	DeleteSlice(sliceHash string) error
+	// RangeSlices calls fn for every committed slice, until fn returns false
+	RangeSlices(fn func(sliceHash string) bool) error
+	Close() error
+}
+
+type SliceReader interface {
+	io.ReaderAt
+	io.Closer
+	Size() int64
+}
+
+// InitSliceStore opens the slice store configured for this node
+func InitSliceStore() error {
+	store, err := newSliceStore(setting.Config.Node.SliceStore, setting.Config.Home.StoragePath)
+	if err != nil {
+		return err
+	}
+	sliceStoreMutex.Lock()
+	defer sliceStoreMutex.Unlock()
+	if sliceStore != nil {
+		_ = sliceStore.Close()
+	}
+	sliceStore = store
+	return nil
+}
+
+// CloseSliceStore closes the slice store. It is opened again with the fs backend if a slice is accessed afterward
+func CloseSliceStore() error {
+	closeSliceMetas()
+	sliceStoreMutex.Lock()
+	defer sliceStoreMutex.Unlock()
+	if sliceStore == nil {
+		return nil
+	}
+	err := sliceStore.Close()
+	sliceStore = nil
+	return err
+}
+
+// GetSliceStore returns the slice store of the node. When none was initialized, slices are stored in the file system
+func GetSliceStore() SliceStore {
+	sliceStoreMutex.RLock()
+	store := sliceStore
+	sliceStoreMutex.RUnlock()
+	if store != nil {
+		return store
+	}
+
+	sliceStoreMutex.Lock()
+	defer sliceStoreMutex.Unlock()
+	if sliceStore == nil {
+		sliceStore = newFsSliceStore(setting.Config.Home.StoragePath)
+	}
+	return sliceStore
+}
+
+func newSliceStore(config setting.SliceStoreConfig, storagePath string) (SliceStore, error) {
+	legacy := newFsSliceStore(storagePath)
+	switch config.Type {
+	case "", SLICE_STORE_FS:
+		return legacy, nil
+	case SLICE_STORE_PACK:
+		store, err := newPackSliceStore(storagePath, int64(config.PackMaxSize)*1024*1024)
+		if err != nil {
+			return nil, err
+		}
+		return &fallbackSliceStore{SliceStore: store, legacy: legacy}, nil
+	case SLICE_STORE_S3:
+		store, err := newS3SliceStore(config.S3, storagePath)
+		if err != nil {
+			return nil, err
+		}
+		return &fallbackSliceStore{SliceStore: store, legacy: legacy}, nil
+	default:
+		return nil, errors.Errorf("unknown slice store type %v", config.Type)
+	}
+}
+
+// fallbackSliceStore keeps the slices written in the fs layout readable after switching to another backend. New slices
+// only go to the new backend
+type fallbackSliceStore struct {
+	SliceStore
+	legacy *fsSliceStore
+}
+
+func (s *fallbackSliceStore) OpenSlice(sliceHash string) (SliceReader, error) {
+	r, err := s.SliceStore.OpenSlice(sliceHash)
+	if errors.Is(err, ErrSliceNotFound) {
+		return s.legacy.OpenSlice(sliceHash)
+	}
+	return r, err
+}
+
+func (s *fallbackSliceStore) ReadSlice(sliceHash string) ([]byte, error) {
+	data, err := s.SliceStore.ReadSlice(sliceHash)
+	if errors.Is(err, ErrSliceNotFound) {
+		return s.legacy.ReadSlice(sliceHash)
+	}
+	return data, err
+}
+
+func (s *fallbackSliceStore) SliceSize(sliceHash string) (int64, error) {
+	size, err := s.SliceStore.SliceSize(sliceHash)
+	if errors.Is(err, ErrSliceNotFound) {
+		return s.legacy.SliceSize(sliceHash)
+	}
+	return size, err
+}
+
+func (s *fallbackSliceStore) DeleteSlice(sliceHash string) error {
+	err := s.SliceStore.DeleteSlice(sliceHash)
+	if errors.Is(err, ErrSliceNotFound) {
+		return s.legacy.DeleteSlice(sliceHash)
+	}
+	return err
+}
+
+func (s *fallbackSliceStore) RangeSlices(fn func(sliceHash string) bool) error {
+	stopped := false
+	err := s.SliceStore.RangeSlices(func(sliceHash string) bool {
+		stopped = !fn(sliceHash)
+		return !stopped
+	})
+	if err != nil || stopped {
+		return err
+	}
+	return s.legacy.RangeSlices(fn)
+}
+
+// stagingArea holds the slices being received by the backends which can't write a slice in place
+type stagingArea struct {
+	dir string
+}
+
+func newStagingArea(storagePath string) (*stagingArea, error) {
+	dir := filepath.Join(storagePath, STAGING_FOLDER)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "failed creating dir")
+	}
+	return &stagingArea{dir: dir}, nil
+}
+
+func (s *stagingArea) path(sliceHash string) (string, error) {
+	if sliceHash == "" || filepath.Base(sliceHash) != sliceHash {
+		return "", errors.New("invalid slice hash")
+	}
+	return filepath.Join(s.dir, sliceHash), nil
+}
+
+func (s *stagingArea) write(sliceHash string, data []byte, offset uint64) error {
+	slicePath, err := s.path(sliceHash)
+	if err != nil {
+		return err
+	}
+	return writeAt(slicePath, data, offset)
+}
+
+func (s *stagingArea) open(sliceHash string) (SliceReader, error) {
+	slicePath, err := s.path(sliceHash)
+	if err != nil {
+		return nil, err
+	}
+	return openFileSlice(slicePath)
+}
+
+func (s *stagingArea) read(sliceHash string) ([]byte, error) {
+	slicePath, err := s.path(sliceHash)
+	if err != nil {
+		return nil, err
+	}
+	return readWholeFile(slicePath)
+}
+
+func (s *stagingArea) size(sliceHash string) (int64, error) {
+	slicePath, err := s.path(sliceHash)
+	if err != nil {
+		return 0, err
+	}
+	return fileSize(slicePath)
+}
+
+// remove deletes the staged slice, and reports whether it existed
+func (s *stagingArea) remove(sliceHash string) (bool, error) {
+	slicePath, err := s.path(sliceHash)
+	if err != nil {
+		return false, err
+	}
+	err = os.Remove(slicePath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed removing staged slice")
+	}
+	return true, nil
+}
+
+func writeAt(filePath string, data []byte, offset uint64) error {
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening a file")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err = f.WriteAt(data, int64(offset)); err != nil {
+		return errors.Wrap(err, "failed writing data")
+	}
+	return nil
+}
+
+func readWholeFile(filePath string) ([]byte, error) {
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, ErrSliceNotFound
+	}
+	return data, err
+}
+
+func fileSize(filePath string) (int64, error) {
+	info, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return 0, ErrSliceNotFound
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed getting file info")
+	}
+	return info.Size(), nil
+}
+
+type fileSliceReader struct {
+	*os.File
+	size int64
+}
+
+func (r *fileSliceReader) Size() int64 {
+	return r.size
+}
+
+func openFileSlice(filePath string) (SliceReader, error) {
+	f, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, ErrSliceNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening slice")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(err, "failed getting file info")
+	}
+	return &fileSliceReader{File: f, size: info.Size()}, nil
+}
+
+type bytesSliceReader struct {
+	data []byte
+}
+
+func (r *bytesSliceReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	if off >= int64(len(r.data)) {
+		return 0, io.EOF
+	}
+	n := copy(p, r.data[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (r *bytesSliceReader) Close() error {
+	return nil
+}
+
+func (r *bytesSliceReader) Size() int64 {
+	return int64(len(r.data))
+}
@@ -0,0 +1,121 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// fsSliceStore stores each slice in its own file, under <storage path>/<hash[:8]>/<hash[8:10]>/<hash>
+type fsSliceStore struct {
+	root string
+}
+
+func newFsSliceStore(root string) *fsSliceStore {
+	return &fsSliceStore{root: root}
+}
+
+func (s *fsSliceStore) slicePath(sliceHash string) (string, error) {
+	if len(sliceHash) < 10 || filepath.Base(sliceHash) != sliceHash {
+		return "", errors.New("wrong size of slice hash")
+	}
+	return filepath.Join(s.root, sliceHash[:8], sliceHash[8:10], sliceHash), nil
+}
+
+func (s *fsSliceStore) WriteSlice(sliceHash string, data []byte, offset uint64) error {
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return errors.Wrap(err, "failed getting slice path")
+	}
+	if err = os.MkdirAll(filepath.Dir(slicePath), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating dir")
+	}
+	return writeAt(slicePath, data, offset)
+}
+
+// CommitSlice slices are written in place, there is nothing left to do
+func (s *fsSliceStore) CommitSlice(sliceHash string) error {
+	return nil
+}
+
+func (s *fsSliceStore) OpenSlice(sliceHash string) (SliceReader, error) {
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return nil, err
+	}
+	return openFileSlice(slicePath)
+}
+
+func (s *fsSliceStore) ReadSlice(sliceHash string) ([]byte, error) {
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return nil, err
+	}
+	return readWholeFile(slicePath)
+}
+
+func (s *fsSliceStore) SliceSize(sliceHash string) (int64, error) {
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed getting slice path")
+	}
+	return fileSize(slicePath)
+}
+
+func (s *fsSliceStore) DeleteSlice(sliceHash string) error {
+	slicePath, err := s.slicePath(sliceHash)
+	if err != nil {
+		return errors.Wrap(err, "failed getting slice path")
+	}
+	err = os.Remove(slicePath)
+	if os.IsNotExist(err) {
+		return ErrSliceNotFound
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed removing slice")
+	}
+	return nil
+}
+
+func (s *fsSliceStore) RangeSlices(fn func(sliceHash string) bool) error {
+	level1, err := os.ReadDir(s.root)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed reading storage folder")
+	}
+	for _, dir1 := range level1 {
+		if !dir1.IsDir() || len(dir1.Name()) != 8 {
+			continue
+		}
+		level2, err := os.ReadDir(filepath.Join(s.root, dir1.Name()))
+		if err != nil {
+			return errors.Wrap(err, "failed reading storage folder")
+		}
+		for _, dir2 := range level2 {
+			if !dir2.IsDir() || len(dir2.Name()) != 2 {
+				continue
+			}
+			entries, err := os.ReadDir(filepath.Join(s.root, dir1.Name(), dir2.Name()))
+			if err != nil {
+				return errors.Wrap(err, "failed reading storage folder")
+			}
+			for _, entry := range entries {
+				if !entry.Type().IsRegular() || !strings.HasPrefix(entry.Name(), dir1.Name()+dir2.Name()) {
+					continue
+				}
+				if !fn(entry.Name()) {
+					return nil
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *fsSliceStore) Close() error {
+	return nil
+}
@@ -0,0 +1,560 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const (
+	PACK_FOLDER      = "pack"
+	PACK_INDEX_FILE  = "index.log"
+	PACK_FILE_PREFIX = "pack-"
+	PACK_FILE_EXT    = ".dat"
+
+	DEFAULT_PACK_MAX_SIZE = 4 * 1024 * 1024 * 1024
+
+	packIndexOpPut    = byte(1)
+	packIndexOpDelete = byte(2)
+
+	// the index is rewritten when it holds this many more records than live slices
+	packIndexMaxGarbage = 10000
+)
+
+// packSliceStore appends the committed slices to large pack files, which avoids creating millions of small files.
+// The location of each slice is recorded in an append-only index log, replayed when the store is opened.
+// A pack file is compacted in the background once less than half of it is still used
+type packSliceStore struct {
+	dir         string
+	maxPackSize int64
+	staging     *stagingArea
+
+	mutex      sync.RWMutex
+	index      map[string]packLocation
+	liveBytes  map[uint32]int64 // pack id -> size of the slices still in the pack
+	indexFile  *os.File
+	records    int // number of records in the index log
+	activeId   uint32
+	activePack *os.File
+	activeSize int64
+	lastId     uint32          // highest pack id in use, the packs written by a compaction aren't active
+	compacting map[uint32]bool // pack id -> being compacted
+	closed     bool
+	compactWg  sync.WaitGroup
+}
+
+type packLocation struct {
+	packId uint32
+	offset int64
+	size   int64
+}
+
+func newPackSliceStore(storagePath string, maxPackSize int64) (*packSliceStore, error) {
+	if maxPackSize <= 0 {
+		maxPackSize = DEFAULT_PACK_MAX_SIZE
+	}
+	staging, err := newStagingArea(storagePath)
+	if err != nil {
+		return nil, err
+	}
+	s := &packSliceStore{
+		dir:         filepath.Join(storagePath, PACK_FOLDER),
+		maxPackSize: maxPackSize,
+		staging:     staging,
+		index:       make(map[string]packLocation),
+		liveBytes:   make(map[uint32]int64),
+		compacting:  make(map[uint32]bool),
+	}
+	if err = os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "failed creating dir")
+	}
+	if err = s.loadIndex(); err != nil {
+		return nil, err
+	}
+	if err = s.openActivePack(); err != nil {
+		_ = s.indexFile.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *packSliceStore) packPath(packId uint32) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%v%08d%v", PACK_FILE_PREFIX, packId, PACK_FILE_EXT))
+}
+
+// loadIndex replays the index log. A record torn by a crash is dropped, the slice will be received again
+func (s *packSliceStore) loadIndex() error {
+	indexPath := filepath.Join(s.dir, PACK_INDEX_FILE)
+	f, err := os.OpenFile(indexPath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening pack index")
+	}
+
+	var validSize int64
+	reader := bufio.NewReader(f)
+	for {
+		op, sliceHash, location, n, err := readPackIndexRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			utils.ErrorLog("dropping the end of the pack index: ", err.Error())
+			break
+		}
+		validSize += n
+		s.records++
+		if old, ok := s.index[sliceHash]; ok {
+			s.liveBytes[old.packId] -= old.size
+			delete(s.index, sliceHash)
+		}
+		if op == packIndexOpPut {
+			s.index[sliceHash] = location
+			s.liveBytes[location.packId] += location.size
+		}
+	}
+	if err = f.Truncate(validSize); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed truncating pack index")
+	}
+	if _, err = f.Seek(validSize, io.SeekStart); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed seeking in pack index")
+	}
+	s.indexFile = f
+
+	if s.records > len(s.index)+packIndexMaxGarbage {
+		return s.rewriteIndex()
+	}
+	return nil
+}
+
+// rewriteIndex replaces the index log by one holding only the live slices
+func (s *packSliceStore) rewriteIndex() error {
+	indexPath := filepath.Join(s.dir, PACK_INDEX_FILE)
+	tmpPath := indexPath + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed creating pack index")
+	}
+	writer := bufio.NewWriter(f)
+	for sliceHash, location := range s.index {
+		if _, err = writer.Write(encodePackIndexRecord(packIndexOpPut, sliceHash, location)); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, indexPath)
+	}
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmpPath)
+		return errors.Wrap(err, "failed rewriting pack index")
+	}
+	_ = s.indexFile.Close()
+	s.indexFile = f
+	s.records = len(s.index)
+	return nil
+}
+
+// openActivePack opens the last pack file for appending, and removes the pack files without any live slice
+func (s *packSliceStore) openActivePack() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return errors.Wrap(err, "failed reading pack folder")
+	}
+	var packIds []uint32
+	for _, entry := range entries {
+		var packId uint32
+		name := entry.Name()
+		if !strings.HasPrefix(name, PACK_FILE_PREFIX) || !strings.HasSuffix(name, PACK_FILE_EXT) {
+			continue
+		}
+		if _, err = fmt.Sscanf(strings.TrimSuffix(strings.TrimPrefix(name, PACK_FILE_PREFIX), PACK_FILE_EXT), "%d", &packId); err != nil {
+			continue
+		}
+		packIds = append(packIds, packId)
+		if packId > s.activeId {
+			s.activeId = packId
+		}
+	}
+	for _, packId := range packIds {
+		if packId != s.activeId && s.liveBytes[packId] <= 0 {
+			_ = os.Remove(s.packPath(packId))
+		}
+	}
+	for packId, size := range s.liveBytes {
+		if size <= 0 {
+			delete(s.liveBytes, packId)
+		}
+	}
+	s.lastId = s.activeId
+	return s.openPack(s.activeId)
+}
+
+func (s *packSliceStore) openPack(packId uint32) error {
+	f, err := os.OpenFile(s.packPath(packId), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening pack file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed getting file info")
+	}
+	if s.activePack != nil {
+		_ = s.activePack.Close()
+	}
+	s.activeId = packId
+	s.activePack = f
+	s.activeSize = info.Size()
+	if packId > s.lastId {
+		s.lastId = packId
+	}
+	return nil
+}
+
+func (s *packSliceStore) WriteSlice(sliceHash string, data []byte, offset uint64) error {
+	return s.staging.write(sliceHash, data, offset)
+}
+
+// CommitSlice appends the staged slice to the active pack file
+func (s *packSliceStore) CommitSlice(sliceHash string) error {
+	r, err := s.staging.open(sliceHash)
+	if errors.Is(err, ErrSliceNotFound) {
+		s.mutex.RLock()
+		_, ok := s.index[sliceHash]
+		s.mutex.RUnlock()
+		if ok {
+			return nil
+		}
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	s.mutex.Lock()
+	err = s.appendSlice(sliceHash, io.NewSectionReader(r, 0, r.Size()), r.Size())
+	s.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+	_, err = s.staging.remove(sliceHash)
+	return err
+}
+
+// appendSlice writes the slice at the end of the active pack and records it in the index. The caller holds the lock
+func (s *packSliceStore) appendSlice(sliceHash string, r io.Reader, size int64) error {
+	if s.activeSize > 0 && s.activeSize+size > s.maxPackSize {
+		if err := s.openPack(s.lastId + 1); err != nil {
+			return err
+		}
+	}
+	location := packLocation{packId: s.activeId, offset: s.activeSize, size: size}
+	if _, err := s.activePack.Seek(location.offset, io.SeekStart); err != nil {
+		return errors.Wrap(err, "failed seeking in pack file")
+	}
+	if _, err := io.Copy(s.activePack, io.LimitReader(r, size)); err != nil {
+		return errors.Wrap(err, "failed writing to pack file")
+	}
+	if err := s.activePack.Sync(); err != nil {
+		return errors.Wrap(err, "failed syncing pack file")
+	}
+	s.activeSize += size
+	if err := s.appendIndexRecord(packIndexOpPut, sliceHash, location); err != nil {
+		return err
+	}
+	if old, ok := s.index[sliceHash]; ok {
+		s.liveBytes[old.packId] -= old.size
+	}
+	s.index[sliceHash] = location
+	s.liveBytes[location.packId] += size
+	return nil
+}
+
+func (s *packSliceStore) appendIndexRecord(op byte, sliceHash string, location packLocation) error {
+	return s.appendIndexRecords(encodePackIndexRecord(op, sliceHash, location), 1)
+}
+
+// appendIndexRecords writes several encoded records to the index log with a single sync
+func (s *packSliceStore) appendIndexRecords(records []byte, count int) error {
+	if _, err := s.indexFile.Write(records); err != nil {
+		return errors.Wrap(err, "failed writing to pack index")
+	}
+	if err := s.indexFile.Sync(); err != nil {
+		return errors.Wrap(err, "failed syncing pack index")
+	}
+	s.records += count
+	return nil
+}
+
+func (s *packSliceStore) OpenSlice(sliceHash string) (SliceReader, error) {
+	if r, err := s.staging.open(sliceHash); !errors.Is(err, ErrSliceNotFound) {
+		return r, err
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	location, ok := s.index[sliceHash]
+	if !ok {
+		return nil, ErrSliceNotFound
+	}
+	// a pack removed by a compaction stays readable through the handles opened before
+	f, err := os.Open(s.packPath(location.packId))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed opening pack file")
+	}
+	return &packSliceReader{SectionReader: io.NewSectionReader(f, location.offset, location.size), file: f}, nil
+}
+
+func (s *packSliceStore) ReadSlice(sliceHash string) ([]byte, error) {
+	r, err := s.OpenSlice(sliceHash)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	data := make([]byte, r.Size())
+	if _, err = r.ReadAt(data, 0); err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "failed reading slice")
+	}
+	return data, nil
+}
+
+func (s *packSliceStore) SliceSize(sliceHash string) (int64, error) {
+	if size, err := s.staging.size(sliceHash); !errors.Is(err, ErrSliceNotFound) {
+		return size, err
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	location, ok := s.index[sliceHash]
+	if !ok {
+		return 0, ErrSliceNotFound
+	}
+	return location.size, nil
+}
+
+func (s *packSliceStore) DeleteSlice(sliceHash string) error {
+	staged, err := s.staging.remove(sliceHash)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	location, ok := s.index[sliceHash]
+	if !ok {
+		if staged {
+			return nil
+		}
+		return ErrSliceNotFound
+	}
+	if err = s.appendIndexRecord(packIndexOpDelete, sliceHash, packLocation{}); err != nil {
+		return err
+	}
+	delete(s.index, sliceHash)
+	s.liveBytes[location.packId] -= location.size
+
+	if location.packId != s.activeId && !s.compacting[location.packId] && !s.closed {
+		if info, err := os.Stat(s.packPath(location.packId)); err == nil && s.liveBytes[location.packId]*2 < info.Size() {
+			s.compacting[location.packId] = true
+			s.compactWg.Add(1)
+			go s.compactPack(location.packId)
+		}
+	}
+	return nil
+}
+
+// compactPack copies the live slices of a pack to a new pack without holding the lock, then swaps the new pack in.
+// The slices deleted or committed again during the copy keep their current location
+func (s *packSliceStore) compactPack(packId uint32) {
+	defer s.compactWg.Done()
+	if err := s.doCompactPack(packId); err != nil {
+		utils.ErrorLog("failed compacting pack file", packId, err.Error())
+	}
+	s.mutex.Lock()
+	delete(s.compacting, packId)
+	s.mutex.Unlock()
+}
+
+func (s *packSliceStore) doCompactPack(packId uint32) error {
+	s.mutex.Lock()
+	slices := make(map[string]packLocation)
+	for sliceHash, location := range s.index {
+		if location.packId == packId {
+			slices[sliceHash] = location
+		}
+	}
+	s.lastId++
+	newId := s.lastId
+	s.mutex.Unlock()
+
+	src, err := os.Open(s.packPath(packId))
+	if err != nil {
+		return errors.Wrap(err, "failed opening pack file")
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+	dst, err := os.OpenFile(s.packPath(newId), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed creating pack file")
+	}
+	newSlices := make(map[string]packLocation, len(slices))
+	var offset int64
+	for sliceHash, location := range slices {
+		if _, err = io.Copy(dst, io.NewSectionReader(src, location.offset, location.size)); err != nil {
+			break
+		}
+		newSlices[sliceHash] = packLocation{packId: newId, offset: offset, size: location.size}
+		offset += location.size
+	}
+	if err == nil {
+		err = dst.Sync()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(s.packPath(newId))
+		return errors.Wrap(err, "failed writing pack file")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var records []byte
+	var moved []string
+	for sliceHash, location := range newSlices {
+		if s.index[sliceHash] == slices[sliceHash] {
+			records = append(records, encodePackIndexRecord(packIndexOpPut, sliceHash, location)...)
+			moved = append(moved, sliceHash)
+		}
+	}
+	if len(moved) > 0 {
+		if err = s.appendIndexRecords(records, len(moved)); err != nil {
+			_ = os.Remove(s.packPath(newId))
+			return err
+		}
+	}
+	for _, sliceHash := range moved {
+		location := newSlices[sliceHash]
+		s.liveBytes[packId] -= location.size
+		s.liveBytes[newId] += location.size
+		s.index[sliceHash] = location
+	}
+	if s.liveBytes[newId] <= 0 {
+		delete(s.liveBytes, newId)
+		_ = os.Remove(s.packPath(newId))
+	}
+	if s.liveBytes[packId] > 0 {
+		return errors.New("the pack still holds live slices")
+	}
+	delete(s.liveBytes, packId)
+	// a pack removed by a compaction stays readable through the handles opened before
+	if err = os.Remove(s.packPath(packId)); err != nil {
+		return errors.Wrap(err, "failed removing pack file")
+	}
+	return nil
+}
+
+func (s *packSliceStore) RangeSlices(fn func(sliceHash string) bool) error {
+	s.mutex.RLock()
+	sliceHashes := make([]string, 0, len(s.index))
+	for sliceHash := range s.index {
+		sliceHashes = append(sliceHashes, sliceHash)
+	}
+	s.mutex.RUnlock()
+
+	for _, sliceHash := range sliceHashes {
+		if !fn(sliceHash) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *packSliceStore) Close() error {
+	s.mutex.Lock()
+	s.closed = true
+	s.mutex.Unlock()
+	s.compactWg.Wait()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	err := s.activePack.Close()
+	if indexErr := s.indexFile.Close(); err == nil {
+		err = indexErr
+	}
+	return err
+}
+
+type packSliceReader struct {
+	*io.SectionReader
+	file *os.File
+}
+
+func (r *packSliceReader) Close() error {
+	return r.file.Close()
+}
+
+// encodePackIndexRecord op (1) | hash length (1) | hash | pack id (4) | offset (8) | size (8) | crc32 of the previous fields (4)
+func encodePackIndexRecord(op byte, sliceHash string, location packLocation) []byte {
+	record := make([]byte, 0, 2+len(sliceHash)+24)
+	record = append(record, op, byte(len(sliceHash)))
+	record = append(record, sliceHash...)
+	record = binary.BigEndian.AppendUint32(record, location.packId)
+	record = binary.BigEndian.AppendUint64(record, uint64(location.offset))
+	record = binary.BigEndian.AppendUint64(record, uint64(location.size))
+	return binary.BigEndian.AppendUint32(record, crc32.ChecksumIEEE(record))
+}
+
+func readPackIndexRecord(r *bufio.Reader) (op byte, sliceHash string, location packLocation, n int64, err error) {
+	header := make([]byte, 2)
+	if _, err = io.ReadFull(r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = errors.New("truncated record")
+		}
+		return
+	}
+	body := make([]byte, int(header[1])+24)
+	if _, err = io.ReadFull(r, body); err != nil {
+		err = errors.New("truncated record")
+		return
+	}
+	record := append(header, body...)
+	crcOffset := len(record) - 4
+	if crc32.ChecksumIEEE(record[:crcOffset]) != binary.BigEndian.Uint32(record[crcOffset:]) {
+		err = errors.New("corrupted record")
+		return
+	}
+	op = header[0]
+	if op != packIndexOpPut && op != packIndexOpDelete {
+		err = errors.Errorf("unknown operation %v", op)
+		return
+	}
+	fields := body[header[1]:]
+	sliceHash = string(body[:header[1]])
+	location.packId = binary.BigEndian.Uint32(fields[0:4])
+	location.offset = int64(binary.BigEndian.Uint64(fields[4:12]))
+	location.size = int64(binary.BigEndian.Uint64(fields[12:20]))
+	n = int64(len(record))
+	return
+}
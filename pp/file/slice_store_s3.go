@@ -0,0 +1,295 @@
+package file
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/pp/sigv4"
+)
+
+const (
+	s3RequestTimeout = 5 * time.Minute
+)
+
+// s3SliceStore stores each committed slice as an object of an S3-compatible bucket. Slices are received in the staging
+// area, and uploaded once complete
+type s3SliceStore struct {
+	client  *s3Client
+	prefix  string
+	staging *stagingArea
+}
+
+func newS3SliceStore(config setting.S3StoreConfig, storagePath string) (*s3SliceStore, error) {
+	client, err := newS3Client(config)
+	if err != nil {
+		return nil, err
+	}
+	staging, err := newStagingArea(storagePath)
+	if err != nil {
+		return nil, err
+	}
+	return &s3SliceStore{client: client, prefix: config.Prefix, staging: staging}, nil
+}
+
+func (s *s3SliceStore) WriteSlice(sliceHash string, data []byte, offset uint64) error {
+	return s.staging.write(sliceHash, data, offset)
+}
+
+// CommitSlice uploads the staged slice to the bucket
+func (s *s3SliceStore) CommitSlice(sliceHash string) error {
+	data, err := s.staging.read(sliceHash)
+	if errors.Is(err, ErrSliceNotFound) {
+		if _, err = s.client.headObject(s.prefix + sliceHash); err == nil {
+			return nil
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if err = s.client.putObject(s.prefix+sliceHash, data); err != nil {
+		return err
+	}
+	_, err = s.staging.remove(sliceHash)
+	return err
+}
+
+func (s *s3SliceStore) OpenSlice(sliceHash string) (SliceReader, error) {
+	if r, err := s.staging.open(sliceHash); !errors.Is(err, ErrSliceNotFound) {
+		return r, err
+	}
+	data, err := s.client.getObject(s.prefix + sliceHash)
+	if err != nil {
+		return nil, err
+	}
+	return &bytesSliceReader{data: data}, nil
+}
+
+func (s *s3SliceStore) ReadSlice(sliceHash string) ([]byte, error) {
+	if data, err := s.staging.read(sliceHash); !errors.Is(err, ErrSliceNotFound) {
+		return data, err
+	}
+	return s.client.getObject(s.prefix + sliceHash)
+}
+
+func (s *s3SliceStore) SliceSize(sliceHash string) (int64, error) {
+	if size, err := s.staging.size(sliceHash); !errors.Is(err, ErrSliceNotFound) {
+		return size, err
+	}
+	return s.client.headObject(s.prefix + sliceHash)
+}
+
+func (s *s3SliceStore) DeleteSlice(sliceHash string) error {
+	staged, err := s.staging.remove(sliceHash)
+	if err != nil {
+		return err
+	}
+	if _, err = s.client.headObject(s.prefix + sliceHash); err != nil {
+		if errors.Is(err, ErrSliceNotFound) && staged {
+			return nil
+		}
+		return err
+	}
+	return s.client.deleteObject(s.prefix + sliceHash)
+}
+
+func (s *s3SliceStore) RangeSlices(fn func(sliceHash string) bool) error {
+	return s.client.listObjects(s.prefix, func(key string) bool {
+		return fn(strings.TrimPrefix(key, s.prefix))
+	})
+}
+
+func (s *s3SliceStore) Close() error {
+	return nil
+}
+
+// s3Client is a minimal client of the S3 API, using path-style requests signed with AWS signature version 4
+type s3Client struct {
+	endpoint   *url.URL
+	region     string
+	bucket     string
+	accessKey  string
+	secretKey  string
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+func newS3Client(config setting.S3StoreConfig) (*s3Client, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, errors.New("the endpoint and the bucket of the s3 slice store must be configured")
+	}
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, errors.Errorf("invalid s3 endpoint %v", config.Endpoint)
+	}
+	region := config.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	return &s3Client{
+		endpoint:   endpoint,
+		region:     region,
+		bucket:     config.Bucket,
+		accessKey:  config.AccessKey,
+		secretKey:  config.SecretKey,
+		httpClient: &http.Client{Timeout: s3RequestTimeout},
+		now:        time.Now,
+	}, nil
+}
+
+func (c *s3Client) putObject(key string, data []byte) error {
+	rsp, err := c.do(http.MethodPut, key, nil, data)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rsp.Body.Close()
+	}()
+	return checkS3Response(rsp, key)
+}
+
+func (c *s3Client) getObject(key string) ([]byte, error) {
+	rsp, err := c.do(http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rsp.Body.Close()
+	}()
+	if err = checkS3Response(rsp, key); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading s3 object")
+	}
+	return data, nil
+}
+
+func (c *s3Client) headObject(key string) (int64, error) {
+	rsp, err := c.do(http.MethodHead, key, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = rsp.Body.Close()
+	}()
+	if err = checkS3Response(rsp, key); err != nil {
+		return 0, err
+	}
+	return rsp.ContentLength, nil
+}
+
+func (c *s3Client) deleteObject(key string) error {
+	rsp, err := c.do(http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rsp.Body.Close()
+	}()
+	return checkS3Response(rsp, key)
+}
+
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// listObjects calls fn with the key of every object starting with prefix, using ListObjectsV2
+func (c *s3Client) listObjects(prefix string, fn func(key string) bool) error {
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		rsp, err := c.do(http.MethodGet, "", query, nil)
+		if err != nil {
+			return err
+		}
+		result := &s3ListBucketResult{}
+		err = checkS3Response(rsp, "")
+		if err == nil {
+			err = xml.NewDecoder(rsp.Body).Decode(result)
+		}
+		_ = rsp.Body.Close()
+		if err != nil {
+			return errors.Wrap(err, "failed listing s3 objects")
+		}
+		for _, object := range result.Contents {
+			if !fn(object.Key) {
+				return nil
+			}
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (c *s3Client) do(method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = sigv4.Escape(u.Path, true)
+	u.RawQuery = sigv4.CanonicalQuery(query)
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating s3 request")
+	}
+	req.ContentLength = int64(len(body))
+	c.sign(req, body)
+	rsp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed sending s3 request")
+	}
+	return rsp, nil
+}
+
+// sign adds the AWS signature version 4 headers to the request
+func (c *s3Client) sign(req *http.Request, body []byte) {
+	amzDate := c.now().UTC().Format(sigv4.DateFormat)
+	date := amzDate[:8]
+	payloadHash := sigv4.Sha256Hex(body)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	canonicalRequest := sigv4.CanonicalRequest(req.Method, req.URL.EscapedPath(), req.URL.Query(), signedHeaders,
+		[]string{req.URL.Host, payloadHash, amzDate}, payloadHash)
+	scope := sigv4.Scope(date, c.region)
+	signature := sigv4.Sign(sigv4.SigningKey(c.secretKey, date, c.region), sigv4.StringToSign(amzDate, scope, canonicalRequest))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%v Credential=%v/%v, SignedHeaders=%v, Signature=%v",
+		sigv4.Algorithm, c.accessKey, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+func checkS3Response(rsp *http.Response, key string) error {
+	if rsp.StatusCode >= 200 && rsp.StatusCode < 300 {
+		return nil
+	}
+	if rsp.StatusCode == http.StatusNotFound && key != "" {
+		return ErrSliceNotFound
+	}
+	message, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+	return errors.Errorf("s3 request failed with status %v: %v", rsp.StatusCode, string(message))
+}
@@ -0,0 +1,194 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const testSliceHash = "v05j1m52e0m1ujsnnsnmff0kdt6sfgd8ak5bqfsh"
+
+func testSliceStore(t *testing.T, store SliceStore) {
+	data := bytes.Repeat([]byte("0123456789"), 100)
+	if err := store.WriteSlice(testSliceHash, data[500:], 500); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.WriteSlice(testSliceHash, data[:500], 0); err != nil {
+		t.Fatal(err)
+	}
+	if size, err := store.SliceSize(testSliceHash); err != nil || size != int64(len(data)) {
+		t.Fatal("wrong size of the slice being received", size, err)
+	}
+	if err := store.CommitSlice(testSliceHash); err != nil {
+		t.Fatal(err)
+	}
+
+	if size, err := store.SliceSize(testSliceHash); err != nil || size != int64(len(data)) {
+		t.Fatal("wrong size of the committed slice", size, err)
+	}
+	read, err := store.ReadSlice(testSliceHash)
+	if err != nil || !bytes.Equal(read, data) {
+		t.Fatal("wrong content of the committed slice", err)
+	}
+	r, err := store.OpenSlice(testSliceHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	piece := make([]byte, 10)
+	if _, err = r.ReadAt(piece, 990); (err != nil && err != io.EOF) || string(piece) != "0123456789" {
+		t.Fatal("wrong content read at offset", err)
+	}
+	_ = r.Close()
+
+	var listed []string
+	if err = store.RangeSlices(func(sliceHash string) bool {
+		listed = append(listed, sliceHash)
+		return true
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != 1 || listed[0] != testSliceHash {
+		t.Fatal("wrong slices listed", listed)
+	}
+
+	if err = store.DeleteSlice(testSliceHash); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = store.ReadSlice(testSliceHash); err != ErrSliceNotFound {
+		t.Fatal("the slice should be deleted", err)
+	}
+}
+
+func TestFsSliceStore(t *testing.T) {
+	testSliceStore(t, newFsSliceStore(t.TempDir()))
+}
+
+func TestPackSliceStore(t *testing.T) {
+	utils.NewDefaultLogger("", false, false)
+	dir := t.TempDir()
+	store, err := newPackSliceStore(dir, 2500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceStore(t, store)
+
+	// fill a few packs, then delete most slices of the first one to trigger its compaction
+	for i := 0; i < 6; i++ {
+		sliceHash := fmt.Sprintf("%v%v", testSliceHash, i)
+		if err = store.WriteSlice(sliceHash, bytes.Repeat([]byte{byte(i)}, 1000), 0); err != nil {
+			t.Fatal(err)
+		}
+		if err = store.CommitSlice(sliceHash); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = store.DeleteSlice(testSliceHash + "0"); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the index is replayed on reopening
+	store, err = newPackSliceStore(dir, 2500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+	for i := 1; i < 6; i++ {
+		data, err := store.ReadSlice(fmt.Sprintf("%v%v", testSliceHash, i))
+		if err != nil || !bytes.Equal(data, bytes.Repeat([]byte{byte(i)}, 1000)) {
+			t.Fatal("wrong content of slice", i, err)
+		}
+	}
+	if _, err = store.ReadSlice(testSliceHash + "0"); err != ErrSliceNotFound {
+		t.Fatal("the slice should be deleted", err)
+	}
+	if exist, _ := PathExists(store.packPath(0)); exist {
+		t.Fatal("the first pack should be compacted")
+	}
+}
+
+// fakeS3Server implements the subset of the S3 API used by the s3 slice store
+type fakeS3Server struct {
+	mutex   sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") ||
+		r.Header.Get("X-Amz-Content-Sha256") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/bucket")
+	if key == "" || key == "/" {
+		var keys []string
+		for k := range s.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		_, _ = io.WriteString(w, "<ListBucketResult>")
+		for _, k := range keys {
+			_, _ = fmt.Fprintf(w, "<Contents><Key>%v</Key></Contents>", k)
+		}
+		_, _ = io.WriteString(w, "<IsTruncated>false</IsTruncated></ListBucketResult>")
+		return
+	}
+	key = strings.TrimPrefix(key, "/")
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		s.objects[key] = data
+	case http.MethodGet, http.MethodHead:
+		data, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprint(len(data)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3SliceStore(t *testing.T) {
+	fake := &fakeS3Server{objects: make(map[string][]byte)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store, err := newS3SliceStore(setting.S3StoreConfig{
+		Endpoint:  server.URL,
+		Bucket:    "bucket",
+		Prefix:    "slices/",
+		AccessKey: "access",
+		SecretKey: "secret",
+	}, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testSliceStore(t, store)
+	if len(fake.objects) != 0 {
+		t.Fatal("the slice object should be deleted")
+	}
+}
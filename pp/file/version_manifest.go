@@ -0,0 +1,192 @@
+package file
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/crypto"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const (
+	VERSION_MANIFEST_VERSION = 1
+	VERSION_MANIFEST_EXT     = ".sdsver.json"
+	MAX_OBJECT_KEY_LENGTH    = 256
+)
+
+// ObjectVersion one version of a versioned object
+type ObjectVersion struct {
+	Number     uint64 `json:"number"`
+	FileHandle string `json:"file_handle"`
+	FileName   string `json:"file_name"`
+	FileSize   uint64 `json:"file_size"`
+	Timestamp  int64  `json:"timestamp"`
+	// EncryptionTag the encryption tag of the file, empty when it isn't encrypted
+	EncryptionTag string `json:"encryption_tag,omitempty"`
+	// RollbackOf the number of the version restored by a rollback, 0 when the version was uploaded
+	RollbackOf uint64 `json:"rollback_of,omitempty"`
+}
+
+// VersionManifest the history of a versioned object. Each change uploads a new manifest with the next sequence, signed
+// by the wallet owning the object, so the history can be found and trusted from any node of that wallet
+type VersionManifest struct {
+	Version   int             `json:"version"`
+	Wallet    string          `json:"wallet"`
+	Key       string          `json:"key"`
+	Sequence  uint64          `json:"sequence"`
+	Versions  []ObjectVersion `json:"versions"`
+	Pubkey    string          `json:"pubkey"`
+	Signature string          `json:"signature,omitempty"`
+}
+
+// Latest the current version of the object, the last one of the history
+func (m *VersionManifest) Latest() *ObjectVersion {
+	if len(m.Versions) == 0 {
+		return nil
+	}
+	return &m.Versions[len(m.Versions)-1]
+}
+
+// GetVersion the version with this number, or the latest one when number is 0
+func (m *VersionManifest) GetVersion(number uint64) (*ObjectVersion, error) {
+	if number == 0 {
+		if latest := m.Latest(); latest != nil {
+			return latest, nil
+		}
+		return nil, errors.Errorf("object %v has no version", m.Key)
+	}
+	for i := range m.Versions {
+		if m.Versions[i].Number == number {
+			return &m.Versions[i], nil
+		}
+	}
+	return nil, errors.Errorf("object %v has no version %v", m.Key, number)
+}
+
+func (m *VersionManifest) signMessage() (string, error) {
+	unsigned := *m
+	unsigned.Signature = ""
+	data, err := json.Marshal(unsigned)
+	if err != nil {
+		return "", errors.Wrap(err, "failed encoding the version manifest")
+	}
+	return string(data), nil
+}
+
+// Sign signs the manifest with the wallet of the node
+func (m *VersionManifest) Sign() error {
+	if setting.WalletPrivateKey == nil {
+		return errors.New("wallet is not loaded")
+	}
+	pubkey, err := fwtypes.WalletPubKeyToBech32(setting.WalletPublicKey)
+	if err != nil {
+		return err
+	}
+	m.Wallet = setting.WalletAddress
+	m.Pubkey = pubkey
+	message, err := m.signMessage()
+	if err != nil {
+		return err
+	}
+	signature, err := setting.WalletPrivateKey.Sign([]byte(message))
+	if err != nil {
+		return errors.Wrap(err, "wallet failed to sign the version manifest")
+	}
+	m.Signature = hex.EncodeToString(signature)
+	return nil
+}
+
+// Verify checks that the manifest is the history of the object key, signed by the wallet
+func (m *VersionManifest) Verify(walletAddress, key string) error {
+	if m.Version != VERSION_MANIFEST_VERSION {
+		return errors.Errorf("unsupported version manifest version %v", m.Version)
+	}
+	if m.Wallet != walletAddress || m.Key != key {
+		return errors.New("the version manifest belongs to another object")
+	}
+	if !fwtypes.VerifyWalletAddr(m.Pubkey, m.Wallet) {
+		return errors.New("the public key of the version manifest doesn't match its wallet")
+	}
+	message, err := m.signMessage()
+	if err != nil {
+		return err
+	}
+	if !fwtypes.VerifyWalletSign(m.Pubkey, m.Signature, message) {
+		return errors.New("wrong signature of the version manifest")
+	}
+	return nil
+}
+
+// ValidateObjectKey checks the key naming a versioned object, e.g. "reports/2024/summary.pdf"
+func ValidateObjectKey(key string) error {
+	if key == "" || len(key) > MAX_OBJECT_KEY_LENGTH {
+		return errors.Errorf("the object key should be 1 to %v bytes", MAX_OBJECT_KEY_LENGTH)
+	}
+	return nil
+}
+
+// VersionManifestName the name the manifest is uploaded with. The key is hashed, so any key up to
+// MAX_OBJECT_KEY_LENGTH gives a short enough file name, and the sequence tells which manifest is the latest. The full
+// key stays in the signed manifest, checked by Verify
+func VersionManifestName(key string, sequence uint64) string {
+	return VersionManifestPrefix(key) + strconv.FormatUint(sequence, 10) + VERSION_MANIFEST_EXT
+}
+
+// VersionManifestPrefix the beginning of the names of all the manifests of the object key
+func VersionManifestPrefix(key string) string {
+	return crypto.CalcHash([]byte(key)) + "."
+}
+
+// ParseVersionManifestName returns the sequence of a manifest of the object key, or false when the file name isn't
+// the name of such a manifest
+func ParseVersionManifestName(key, fileName string) (uint64, bool) {
+	prefix := VersionManifestPrefix(key)
+	if !strings.HasPrefix(fileName, prefix) || !strings.HasSuffix(fileName, VERSION_MANIFEST_EXT) {
+		return 0, false
+	}
+	sequence, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(fileName, prefix), VERSION_MANIFEST_EXT), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return sequence, true
+}
+
+// SaveVersionManifest writes the manifest in the manifest folder, and returns the path of the written file
+func SaveVersionManifest(manifest *VersionManifest, id string) (string, error) {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "failed encoding the version manifest")
+	}
+	folder := filepath.Join(GetManifestFolderPath(), id)
+	if err = os.MkdirAll(folder, os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "failed creating dir")
+	}
+	manifestPath := filepath.Join(folder, VersionManifestName(manifest.Key, manifest.Sequence))
+	if err = os.WriteFile(manifestPath, data, 0600); err != nil {
+		return "", errors.Wrap(err, "failed writing the version manifest")
+	}
+	return manifestPath, nil
+}
+
+// LoadVersionManifest reads a version manifest file and verifies it is the history of the object key of the wallet
+func LoadVersionManifest(manifestPath, walletAddress, key string) (*VersionManifest, error) {
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading the version manifest")
+	}
+	manifest := &VersionManifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrap(err, "failed decoding the version manifest")
+	}
+	if err = manifest.Verify(walletAddress, key); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
@@ -0,0 +1,69 @@
+package file
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/crypto/secp256k1"
+	fwtypes "github.com/stratosnet/sds/framework/types"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+func TestVersionManifest(t *testing.T) {
+	privateKey, err := secp256k1.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setting.WalletPrivateKey = privateKey
+	setting.WalletPublicKey = privateKey.PubKey()
+	setting.WalletAddress = fwtypes.WalletAddress(privateKey.PubKey().Address()).String()
+	setting.Config = setting.DefaultConfig()
+	setting.SetupRoot(t.TempDir())
+
+	key := "reports/summary.pdf"
+	manifest := &VersionManifest{
+		Version:  VERSION_MANIFEST_VERSION,
+		Key:      key,
+		Sequence: 2,
+		Versions: []ObjectVersion{
+			{Number: 1, FileHandle: "sdm://a/1", Timestamp: 1},
+			{Number: 2, FileHandle: "sdm://a/2", Timestamp: 2},
+		},
+	}
+	if err = manifest.Sign(); err != nil {
+		t.Fatal(err)
+	}
+	manifestPath, err := SaveVersionManifest(manifest, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadVersionManifest(manifestPath, setting.WalletAddress, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latest := loaded.Latest(); latest == nil || latest.FileHandle != "sdm://a/2" {
+		t.Fatal("wrong latest version", latest)
+	}
+	if sequence, ok := ParseVersionManifestName(key, VersionManifestName(key, 2)); !ok || sequence != 2 {
+		t.Fatal("wrong manifest name", sequence)
+	}
+	if _, ok := ParseVersionManifestName("reports", VersionManifestName(key, 2)); ok {
+		t.Fatal("the manifest of another key shouldn't match")
+	}
+	longKey := strings.Repeat("k", MAX_OBJECT_KEY_LENGTH)
+	if err = ValidateObjectKey(longKey); err != nil {
+		t.Fatal(err)
+	}
+	if name := VersionManifestName(longKey, math.MaxUint64); len(name) > 255 {
+		t.Fatal("the manifest name of the longest key is too long for a file name", len(name))
+	}
+
+	loaded.Versions[0].FileHandle = "sdm://a/3"
+	if err = loaded.Verify(setting.WalletAddress, key); err == nil {
+		t.Fatal("a modified manifest shouldn't be verified")
+	}
+	if err = manifest.Verify(setting.WalletAddress, "other"); err == nil {
+		t.Fatal("the manifest of another key shouldn't be verified")
+	}
+}
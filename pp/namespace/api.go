@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	"github.com/stratosnet/sds/framework/core"
 	"github.com/stratosnet/sds/framework/crypto"
@@ -403,7 +404,7 @@ func (api *rpcPubApi) RequestUploadStream(ctx context.Context, param rpc_api.Par
 		}
 
 		fileHandler := event.GetUploadFileHandler(true)
-		fInfo, slices, err := fileHandler.PreUpload(ctx, tmpFilePath, "")
+		fInfo, slices, err := fileHandler.PreUpload(ctx, tmpFilePath, "", nil)
 		if err != nil {
 			_ = file.SetRemoteFileResult(fileHash, rpc_api.Result{Return: rpc_api.INTERNAL_DATA_FAILURE})
 			return
@@ -446,6 +447,60 @@ func (api *rpcPubApi) RequestUploadStream(ctx context.Context, param rpc_api.Par
 	}
 }
 
+func (api *rpcPubApi) RequestUploadDirectory(ctx context.Context, param rpc_api.ParamReqUploadDirectory) rpc_api.DirectoryUploadResult {
+	metrics.RpcReqCount.WithLabelValues("RequestUploadDirectory").Inc()
+	walletAddr := param.Signature.Address
+	pubkey := param.Signature.Pubkey
+	signature := param.Signature.Signature
+
+	// verify if wallet and public key match
+	if !fwtypes.VerifyWalletAddr(pubkey, walletAddr) {
+		return rpc_api.DirectoryUploadResult{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	if !fwtypes.VerifyWalletSign(pubkey, signature, msgutils.GetDirectoryUploadWalletSignMessage(param.DirPath, walletAddr, param.ReqTime)) {
+		return rpc_api.DirectoryUploadResult{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	if time.Since(time.Unix(param.ReqTime, 0)) > SIGNATURE_INFO_TTL {
+		return rpc_api.DirectoryUploadResult{Return: rpc_api.SIGNATURE_FAILURE, Detail: "the signature has expired"}
+	}
+	// the directory is read from the disk of this node, and the files are uploaded with the wallet of the node
+	if walletAddr != setting.WalletAddress {
+		return rpc_api.DirectoryUploadResult{Return: rpc_api.WRONG_WALLET_ADDRESS}
+	}
+	isFile, err := file.IsFile(param.DirPath)
+	if err != nil || isFile {
+		return rpc_api.DirectoryUploadResult{Return: rpc_api.WRONG_INPUT, Detail: "the provided path is not a directory"}
+	}
+
+	reqId := uuid.New().String()
+	file.SetDirectoryUploadResult(reqId, &rpc_api.DirectoryUploadResult{Return: rpc_api.SUCCESS, ReqId: reqId})
+	go func() {
+		result := &rpc_api.DirectoryUploadResult{Return: rpc_api.SUCCESS, ReqId: reqId, Done: true}
+		uploaded, err := event.RequestUploadDirectory(ctx, param.DirPath, param.IsEncrypted, nil, param.DesiredTier, param.AllowHigherTier, param.Concurrency)
+		if err != nil {
+			result.Return = rpc_api.FILE_REQ_FAILURE
+			result.Detail = err.Error()
+		}
+		if uploaded != nil {
+			result.ManifestHandle = uploaded.ManifestHandle
+			result.Files = uploaded.Files
+			result.Failed = uploaded.Failed
+		}
+		file.SetDirectoryUploadResult(reqId, result)
+	}()
+
+	return rpc_api.DirectoryUploadResult{Return: rpc_api.SUCCESS, ReqId: reqId}
+}
+
+func (api *rpcPubApi) GetDirectoryUploadResult(ctx context.Context, param rpc_api.ParamGetDirectoryUploadResult) rpc_api.DirectoryUploadResult {
+	metrics.RpcReqCount.WithLabelValues("GetDirectoryUploadResult").Inc()
+	result, found := file.GetDirectoryUploadResult(param.ReqId)
+	if !found {
+		return rpc_api.DirectoryUploadResult{Return: rpc_api.WRONG_INPUT, Detail: "unknown request id"}
+	}
+	return *result
+}
+
 func (api *rpcPubApi) GetFileStatus(ctx context.Context, param rpc_api.ParamGetFileStatus) rpc_api.FileStatusResult {
 	metrics.RpcReqCount.WithLabelValues("GetFileStatus").Inc()
 
@@ -496,6 +551,9 @@ func (api *rpcPubApi) RequestDownload(ctx context.Context, param rpc_api.ParamRe
 	if err != nil {
 		return rpc_api.Result{Return: rpc_api.WRONG_INPUT}
 	}
+	if param.Recursive {
+		return api.requestDownloadDirectory(ctx, param)
+	}
 	wallet := param.Signature.Address
 	pubkey := param.Signature.Pubkey
 	signature := param.Signature.Signature
@@ -560,6 +618,177 @@ func (api *rpcPubApi) RequestDownload(ctx context.Context, param rpc_api.ParamRe
 	return *result
 }
 
+// requestDownloadDirectory restores a directory from its manifest into the download folder of the node
+func (api *rpcPubApi) requestDownloadDirectory(ctx context.Context, param rpc_api.ParamReqDownloadFile) rpc_api.Result {
+	walletAddr := param.Signature.Address
+	pubkey := param.Signature.Pubkey
+	signature := param.Signature.Signature
+
+	// verify if wallet and public key match
+	if !fwtypes.VerifyWalletAddr(pubkey, walletAddr) {
+		return rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	if !fwtypes.VerifyWalletSign(pubkey, signature, msgutils.GetDirectoryDownloadWalletSignMessage(param.FileHandle, walletAddr, param.ReqTime)) {
+		return rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	if time.Since(time.Unix(param.ReqTime, 0)) > SIGNATURE_INFO_TTL {
+		return rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE, Detail: "the signature has expired"}
+	}
+	// the directory is written on the disk of this node, and the files are downloaded with the wallet of the node
+	if walletAddr != setting.WalletAddress {
+		return rpc_api.Result{Return: rpc_api.WRONG_WALLET_ADDRESS}
+	}
+
+	reqId := uuid.New().String()
+	file.SetDirectoryDownloadResult(reqId, &rpc_api.DirectoryDownloadResult{Return: rpc_api.SUCCESS, ReqId: reqId})
+	go func() {
+		result := &rpc_api.DirectoryDownloadResult{Return: rpc_api.SUCCESS, ReqId: reqId, Done: true}
+		restored, err := event.RequestDownloadDirectory(ctx, param.FileHandle, param.Concurrency)
+		if err != nil {
+			result.Return = rpc_api.FILE_REQ_FAILURE
+			result.Detail = err.Error()
+		}
+		if restored != nil {
+			result.Path = restored.Path
+			result.Files = restored.Files
+			result.Failed = restored.Failed
+		}
+		file.SetDirectoryDownloadResult(reqId, result)
+	}()
+
+	return rpc_api.Result{Return: rpc_api.SUCCESS, ReqId: reqId}
+}
+
+func (api *rpcPubApi) GetDirectoryDownloadResult(ctx context.Context, param rpc_api.ParamGetDirectoryDownloadResult) rpc_api.DirectoryDownloadResult {
+	metrics.RpcReqCount.WithLabelValues("GetDirectoryDownloadResult").Inc()
+	result, found := file.GetDirectoryDownloadResult(param.ReqId)
+	if !found {
+		return rpc_api.DirectoryDownloadResult{Return: rpc_api.WRONG_INPUT, Detail: "unknown request id"}
+	}
+	return *result
+}
+
+const (
+	OBJECT_VERSION_PUT      = "put"
+	OBJECT_VERSION_LIST     = "list"
+	OBJECT_VERSION_GET      = "get"
+	OBJECT_VERSION_ROLLBACK = "rollback"
+)
+
+// RequestPutVersion uploads a file of the node as the new latest version of an object
+func (api *rpcPubApi) RequestPutVersion(ctx context.Context, param rpc_api.ParamReqObjectVersion) rpc_api.ObjectVersionResult {
+	metrics.RpcReqCount.WithLabelValues("RequestPutVersion").Inc()
+	return startObjectVersionRequest(ctx, OBJECT_VERSION_PUT, param, func(result *rpc_api.ObjectVersionResult) error {
+		version, err := event.PutObjectVersion(ctx, param.Key, param.FilePath, param.DesiredTier, param.AllowHigherTier)
+		if err != nil {
+			return err
+		}
+		result.Version = toRpcObjectVersion(version)
+		return nil
+	})
+}
+
+// RequestListVersions lists the version history of an object
+func (api *rpcPubApi) RequestListVersions(ctx context.Context, param rpc_api.ParamReqObjectVersion) rpc_api.ObjectVersionResult {
+	metrics.RpcReqCount.WithLabelValues("RequestListVersions").Inc()
+	return startObjectVersionRequest(ctx, OBJECT_VERSION_LIST, param, func(result *rpc_api.ObjectVersionResult) error {
+		manifest, err := event.GetObjectVersions(ctx, param.Key)
+		if err != nil {
+			return err
+		}
+		for i := range manifest.Versions {
+			result.Versions = append(result.Versions, *toRpcObjectVersion(&manifest.Versions[i]))
+		}
+		return nil
+	})
+}
+
+// RequestGetVersion downloads a version of an object to the download folder of the node, the latest one when the
+// version is 0
+func (api *rpcPubApi) RequestGetVersion(ctx context.Context, param rpc_api.ParamReqObjectVersion) rpc_api.ObjectVersionResult {
+	metrics.RpcReqCount.WithLabelValues("RequestGetVersion").Inc()
+	return startObjectVersionRequest(ctx, OBJECT_VERSION_GET, param, func(result *rpc_api.ObjectVersionResult) error {
+		path, err := event.DownloadObjectVersion(ctx, param.Key, param.Version)
+		if err != nil {
+			return err
+		}
+		result.Path = path
+		return nil
+	})
+}
+
+// RequestRollbackVersion makes a previous version of an object the latest one again
+func (api *rpcPubApi) RequestRollbackVersion(ctx context.Context, param rpc_api.ParamReqObjectVersion) rpc_api.ObjectVersionResult {
+	metrics.RpcReqCount.WithLabelValues("RequestRollbackVersion").Inc()
+	return startObjectVersionRequest(ctx, OBJECT_VERSION_ROLLBACK, param, func(result *rpc_api.ObjectVersionResult) error {
+		version, err := event.RollbackObjectVersion(ctx, param.Key, param.Version, param.DesiredTier, param.AllowHigherTier)
+		if err != nil {
+			return err
+		}
+		result.Version = toRpcObjectVersion(version)
+		return nil
+	})
+}
+
+func (api *rpcPubApi) GetObjectVersionResult(ctx context.Context, param rpc_api.ParamGetObjectVersionResult) rpc_api.ObjectVersionResult {
+	metrics.RpcReqCount.WithLabelValues("GetObjectVersionResult").Inc()
+	result, found := file.GetObjectVersionResult(param.ReqId)
+	if !found {
+		return rpc_api.ObjectVersionResult{Return: rpc_api.WRONG_INPUT, Detail: "unknown request id"}
+	}
+	return *result
+}
+
+// startObjectVersionRequest verifies the request, then runs it in the background. Versioned objects belong to the wallet
+// of the node, since their files are uploaded and downloaded by the node
+func startObjectVersionRequest(ctx context.Context, action string, param rpc_api.ParamReqObjectVersion,
+	run func(result *rpc_api.ObjectVersionResult) error) rpc_api.ObjectVersionResult {
+	walletAddr := param.Signature.Address
+	pubkey := param.Signature.Pubkey
+
+	// verify if wallet and public key match
+	if !fwtypes.VerifyWalletAddr(pubkey, walletAddr) {
+		return rpc_api.ObjectVersionResult{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	signMsg := msgutils.GetObjectVersionWalletSignMessage(action, param.Key, walletAddr, param.ReqTime)
+	if !fwtypes.VerifyWalletSign(pubkey, param.Signature.Signature, signMsg) {
+		return rpc_api.ObjectVersionResult{Return: rpc_api.SIGNATURE_FAILURE}
+	}
+	if time.Since(time.Unix(param.ReqTime, 0)) > SIGNATURE_INFO_TTL {
+		return rpc_api.ObjectVersionResult{Return: rpc_api.SIGNATURE_FAILURE, Detail: "the signature has expired"}
+	}
+	if walletAddr != setting.WalletAddress {
+		return rpc_api.ObjectVersionResult{Return: rpc_api.WRONG_WALLET_ADDRESS}
+	}
+	if err := file.ValidateObjectKey(param.Key); err != nil {
+		return rpc_api.ObjectVersionResult{Return: rpc_api.WRONG_INPUT, Detail: err.Error()}
+	}
+
+	reqId := uuid.New().String()
+	file.SetObjectVersionResult(reqId, &rpc_api.ObjectVersionResult{Return: rpc_api.SUCCESS, ReqId: reqId, Key: param.Key})
+	go func() {
+		result := &rpc_api.ObjectVersionResult{Return: rpc_api.SUCCESS, ReqId: reqId, Key: param.Key, Done: true}
+		if err := run(result); err != nil {
+			result.Return = rpc_api.FILE_REQ_FAILURE
+			result.Detail = err.Error()
+		}
+		file.SetObjectVersionResult(reqId, result)
+	}()
+
+	return rpc_api.ObjectVersionResult{Return: rpc_api.SUCCESS, ReqId: reqId, Key: param.Key}
+}
+
+func toRpcObjectVersion(version *file.ObjectVersion) *rpc_api.ObjectVersion {
+	return &rpc_api.ObjectVersion{
+		Number:     version.Number,
+		FileHandle: version.FileHandle,
+		FileName:   version.FileName,
+		FileSize:   version.FileSize,
+		Timestamp:  version.Timestamp,
+		RollbackOf: version.RollbackOf,
+	}
+}
+
 func (api *rpcPubApi) RequestVideoDownload(ctx context.Context, param rpc_api.ParamReqDownloadFile) rpc_api.Result {
 	metrics.RpcReqCount.WithLabelValues("RequestDownload").Inc()
 	_, _, fileHash, _, err := fwtypes.ParseFileHandle(param.FileHandle)
@@ -841,8 +1070,26 @@ func (api *rpcPubApi) RequestShare(ctx context.Context, param rpc_api.ParamReqSh
 		result := &rpc_api.FileShareResult{Return: rpc_api.SIGNATURE_FAILURE + ", wrong wallet signature"}
 		return *result
 	}
-	event.ReqShareFile(reqCtx, param.FileHash, "", param.Signature.Address, param.Duration, param.PrivateFlag,
-		wpk.Bytes(), wsig, param.ReqTime, param.IpfsCid)
+	if len(param.Recipients) > 0 {
+		recipients, err := shareRecipientsFromParam(param.Recipients)
+		if err != nil {
+			return rpc_api.FileShareResult{Return: rpc_api.WRONG_INPUT, Detail: err.Error()}
+		}
+		// the recipients are stored by the SP with the share, the wallet has to sign them
+		signMsg := msgutils.ShareFileToRecipientsWalletSignMessage(param.FileHash, param.Signature.Address,
+			event.ShareRecipientAddresses(recipients), param.ReqTime)
+		if !fwtypes.VerifyWalletSign(param.Signature.Pubkey, param.Signature.Signature, signMsg) {
+			return rpc_api.FileShareResult{Return: rpc_api.SIGNATURE_FAILURE + ", the recipients aren't signed"}
+		}
+		err = event.ReqShareFileToRecipients(reqCtx, param.FileHash, param.Signature.Address, param.Duration, param.PrivateFlag,
+			recipients, wpk.Bytes(), wsig, param.ReqTime, param.IpfsCid)
+		if err != nil {
+			return rpc_api.FileShareResult{Return: rpc_api.WRONG_INPUT, Detail: err.Error()}
+		}
+	} else {
+		event.ReqShareFile(reqCtx, param.FileHash, "", param.Signature.Address, param.Duration, param.PrivateFlag,
+			wpk.Bytes(), wsig, param.ReqTime, param.IpfsCid)
+	}
 
 	// wait for result, SUCCESS or some failure
 	var result *rpc_api.FileShareResult
@@ -861,6 +1108,29 @@ func (api *rpcPubApi) RequestShare(ctx context.Context, param rpc_api.ParamReqSh
 	}
 }
 
+func shareRecipientsFromParam(params []rpc_api.ShareRecipient) ([]event.ShareRecipient, error) {
+	var recipients []event.ShareRecipient
+	for _, param := range params {
+		recipient := event.ShareRecipient{WalletAddress: param.WalletAddress}
+		if _, err := fwtypes.WalletAddressFromBech32(param.WalletAddress); err != nil {
+			return nil, errors.Errorf("invalid recipient wallet address %v", param.WalletAddress)
+		}
+		var err error
+		if param.EncryptionPubkey != "" {
+			if recipient.EncryptionPubkey, err = hex.DecodeString(param.EncryptionPubkey); err != nil {
+				return nil, errors.Wrap(err, "invalid recipient encryption pubkey")
+			}
+		}
+		if param.WrappedKey != "" {
+			if recipient.WrappedKey, err = hex.DecodeString(param.WrappedKey); err != nil {
+				return nil, errors.Wrap(err, "invalid recipient wrapped key")
+			}
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, nil
+}
+
 func (api *rpcPubApi) RequestListShare(ctx context.Context, param rpc_api.ParamReqListShared) rpc_api.FileShareResult {
 	metrics.RpcReqCount.WithLabelValues("RequestListShare").Inc()
 	reqId := uuid.New().String()
@@ -965,6 +1235,9 @@ func (api *rpcPubApi) RequestGetShared(ctx context.Context, param rpc_api.ParamR
 		utils.ErrorLog("wrong share link")
 		return rpc_api.Result{Return: rpc_api.WRONG_INPUT}
 	}
+	if result := acceptShareRecipient(shareLink, param); result != nil {
+		return *result
+	}
 
 	ctx, cancel := context.WithTimeout(ctx, INIT_WAIT_TIMEOUT)
 	defer cancel()
@@ -1011,6 +1284,22 @@ func (api *rpcPubApi) RequestGetShared(ctx context.Context, param rpc_api.ParamR
 	}
 }
 
+// acceptShareRecipient keeps the recipients carried by a share link, to check them against the ones stored by the SP
+// once the shared file info is received. The wallet of a share link bound to wallets has to prove it signed the request
+func acceptShareRecipient(shareLink *fwtypes.ShareDataMeshId, param rpc_api.ParamReqGetShared) *rpc_api.Result {
+	wallet := param.Signature.Address
+	if shareLink.RecipientKeys != "" {
+		signMsg := msgutils.GetDownloadShareFileWalletSignMessage(shareLink.Link, wallet, param.SequenceNumber, param.ReqTime)
+		if !fwtypes.VerifyWalletSign(param.Signature.Pubkey, param.Signature.Signature, signMsg) {
+			return &rpc_api.Result{Return: rpc_api.SIGNATURE_FAILURE}
+		}
+	}
+	if err := event.SaveShareLinkRecipients(shareLink.Link, shareLink.RecipientKeys, wallet); err != nil {
+		return &rpc_api.Result{Return: rpc_api.WRONG_INPUT, Detail: err.Error()}
+	}
+	return nil
+}
+
 func (api *rpcPubApi) RequestGetVideoShared(ctx context.Context, param rpc_api.ParamReqGetShared) rpc_api.Result {
 	metrics.RpcReqCount.WithLabelValues("RequestGetShared").Inc()
 	wallet := param.Signature.Address
@@ -1039,6 +1328,9 @@ func (api *rpcPubApi) RequestGetVideoShared(ctx context.Context, param rpc_api.P
 		utils.ErrorLog("wrong share link")
 		return rpc_api.Result{Return: rpc_api.WRONG_INPUT}
 	}
+	if result := acceptShareRecipient(shareLink, param); result != nil {
+		return *result
+	}
 
 	reqId := uuid.New().String()
 	ctx, cancel := context.WithTimeout(ctx, WAIT_TIMEOUT)
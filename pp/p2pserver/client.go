@@ -14,6 +14,7 @@ import (
 	"github.com/stratosnet/sds/framework/core"
 	"github.com/stratosnet/sds/framework/msg"
 	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/capture"
 	"github.com/stratosnet/sds/pp/setting"
 )
 
@@ -121,10 +122,32 @@ func (p *P2pServer) newClient(ctx context.Context, server string, heartbeat, rec
 		cf.LogOpenOption(true),
 		cf.MinAppVersionOption(setting.Config.Version.MinAppVer),
 		cf.P2pAddressOption(p.GetP2PAddress().String()),
+		cf.P2pKeyOption(p.p2pPrivKey),
+		cf.MinHandshakeVersionOption(setting.Config.Node.Connectivity.MinHandshakeVersion),
+		cf.PeerHandshakeVersionOption(p.peerHandshakeVersion(server, spconn)),
+		cf.CompressionOption(setting.Config.Node.Connectivity.CompressionThreshold),
+		cf.CaptureOption(capture.Message),
 		cf.ServerIpOption(setting.NetworkIP),
 		serverPortOpt,
 		cf.ContextKVOption(ckv),
 	}
+	if !spconn {
+		var dialOption cf.ClientOption
+		ok := false
+		// the relay and QUIC routes are advertised for a P2P address, which the PP must prove whatever the transport
+		if p2pAddress, known := p.getPpP2pAddress(server); known {
+			options = append(options, cf.RemoteP2pAddressOption(p2pAddress))
+			if dialOption, ok = p.relayDialOption(p2pAddress); !ok {
+				dialOption, ok = p.quicDialOption(p2pAddress)
+			}
+		}
+		if !ok {
+			dialOption, ok = p.muxDialOption(server)
+		}
+		if ok {
+			options = append(options, dialOption)
+		}
+	}
 	conn := cf.CreateClientConn(0, server, options...)
 
 	// setting p.mainSpConn earlier than calling conn.Start() to avoid race condition
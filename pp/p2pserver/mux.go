@@ -0,0 +1,18 @@
+package p2pserver
+
+import (
+	"net"
+
+	"github.com/stratosnet/sds/framework/client/cf"
+)
+
+// muxDialOption the option opening the conn to the PP as a stream of the mux conn to it, if multiplexing is enabled.
+// When the PP doesn't support it, the conn falls back to a TCP connection of its own
+func (p *P2pServer) muxDialOption(networkAddress string) (cf.ClientOption, bool) {
+	if p.muxDialer == nil {
+		return nil, false
+	}
+	return cf.DialOption(func() (net.Conn, error) {
+		return p.muxDialer.Dial(networkAddress)
+	}), true
+}
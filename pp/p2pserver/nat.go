@@ -0,0 +1,165 @@
+package p2pserver
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/huin/goupnp/dcps/internetgateway2"
+	natpmp "github.com/jackpal/go-nat-pmp"
+	"github.com/pkg/errors"
+)
+
+const (
+	portMappingLifetime    = time.Hour
+	portMappingRenewal     = portMappingLifetime / 2
+	portMappingTimeout     = 3 * time.Second
+	portMappingDescription = "sds resource node"
+)
+
+// portMapping a TCP port of the gateway of the local network, forwarded to the node
+type portMapping struct {
+	protocol     string
+	externalIP   net.IP
+	externalPort uint16
+	renew        func() error
+	remove       func()
+}
+
+// mapPort asks the gateway of the local network to forward the external port to the internal port of the node, with
+// UPnP, or with NAT-PMP when no UPnP gateway answers
+func mapPort(internalPort, externalPort uint16) (*portMapping, error) {
+	mapping, upnpErr := mapPortUpnp(internalPort, externalPort)
+	if upnpErr == nil {
+		return mapping, nil
+	}
+	mapping, natPmpErr := mapPortNatPmp(internalPort, externalPort)
+	if natPmpErr == nil {
+		return mapping, nil
+	}
+	return nil, errors.Errorf("couldn't map port %v (UPnP: %v, NAT-PMP: %v)", externalPort, upnpErr.Error(), natPmpErr.Error())
+}
+
+type upnpClient interface {
+	AddPortMapping(remoteHost string, externalPort uint16, protocol string, internalPort uint16, internalClient string,
+		enabled bool, description string, leaseDuration uint32) error
+	DeletePortMapping(remoteHost string, externalPort uint16, protocol string) error
+	GetExternalIPAddress() (string, error)
+	LocalAddr() net.IP
+}
+
+// upnpClients the services of the UPnP gateways able to forward a port
+func upnpClients() []upnpClient {
+	ctx, cancel := context.WithTimeout(context.Background(), portMappingTimeout)
+	defer cancel()
+
+	var clients []upnpClient
+	ipClients2, _, _ := internetgateway2.NewWANIPConnection2ClientsCtx(ctx)
+	for _, client := range ipClients2 {
+		clients = append(clients, client)
+	}
+	ipClients1, _, _ := internetgateway2.NewWANIPConnection1ClientsCtx(ctx)
+	for _, client := range ipClients1 {
+		clients = append(clients, client)
+	}
+	pppClients, _, _ := internetgateway2.NewWANPPPConnection1ClientsCtx(ctx)
+	for _, client := range pppClients {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
+func mapPortUpnp(internalPort, externalPort uint16) (*portMapping, error) {
+	clients := upnpClients()
+	if len(clients) == 0 {
+		return nil, errors.New("no UPnP gateway found")
+	}
+	var err error
+	for _, client := range clients {
+		var mapping *portMapping
+		if mapping, err = mapPortUpnpClient(client, internalPort, externalPort); err == nil {
+			return mapping, nil
+		}
+	}
+	return nil, err
+}
+
+func mapPortUpnpClient(client upnpClient, internalPort, externalPort uint16) (*portMapping, error) {
+	externalAddress, err := client.GetExternalIPAddress()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed getting the external IP of the UPnP gateway")
+	}
+	externalIP := net.ParseIP(externalAddress)
+	if externalIP == nil {
+		return nil, errors.Errorf("invalid external IP [%v] from the UPnP gateway", externalAddress)
+	}
+	localIP := client.LocalAddr()
+	if localIP == nil {
+		return nil, errors.New("unknown local IP on the network of the UPnP gateway")
+	}
+	add := func() error {
+		return client.AddPortMapping("", externalPort, "TCP", internalPort, localIP.String(), true, portMappingDescription,
+			uint32(portMappingLifetime/time.Second))
+	}
+	if err = add(); err != nil {
+		return nil, errors.Wrap(err, "the UPnP gateway refused the port mapping")
+	}
+	return &portMapping{
+		protocol:     "UPnP",
+		externalIP:   externalIP,
+		externalPort: externalPort,
+		renew:        add,
+		remove: func() {
+			_ = client.DeletePortMapping("", externalPort, "TCP")
+		},
+	}, nil
+}
+
+func mapPortNatPmp(internalPort, externalPort uint16) (*portMapping, error) {
+	err := errors.New("no private network")
+	for _, gateway := range potentialGateways() {
+		client := natpmp.NewClientWithTimeout(gateway, portMappingTimeout)
+		var address *natpmp.GetExternalAddressResult
+		if address, err = client.GetExternalAddress(); err != nil {
+			continue
+		}
+		var result *natpmp.AddPortMappingResult
+		result, err = client.AddPortMapping("tcp", int(internalPort), int(externalPort), int(portMappingLifetime/time.Second))
+		if err != nil {
+			continue
+		}
+		mappedPort := result.MappedExternalPort // the gateway can choose another port
+		return &portMapping{
+			protocol:     "NAT-PMP",
+			externalIP:   net.IP(address.ExternalIPAddress[:]),
+			externalPort: mappedPort,
+			renew: func() error {
+				_, err := client.AddPortMapping("tcp", int(internalPort), int(mappedPort), int(portMappingLifetime/time.Second))
+				return err
+			},
+			remove: func() {
+				_, _ = client.AddPortMapping("tcp", int(internalPort), 0, 0)
+			},
+		}, nil
+	}
+	return nil, err
+}
+
+// potentialGateways NAT-PMP has no discovery, the gateway is assumed to be the first address of each private network
+func potentialGateways() []net.IP {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil
+	}
+	var gateways []net.IP
+	for _, address := range addrs {
+		ipnet, ok := address.(*net.IPNet)
+		if !ok || ipnet.IP.To4() == nil || !ipnet.IP.IsPrivate() {
+			continue
+		}
+		gateway := ipnet.IP.Mask(ipnet.Mask).To4()
+		gateway[3] |= 1
+		gateways = append(gateways, gateway)
+	}
+	return gateways
+}
@@ -9,6 +9,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/alex023/clock"
+
 	"github.com/stratosnet/sds/framework/client/cf"
 	"github.com/stratosnet/sds/framework/core"
 	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
@@ -19,6 +21,7 @@ import (
 	"github.com/stratosnet/sds/sds-msg/protos"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/stratosnet/sds/pp/capture"
 	"github.com/stratosnet/sds/pp/setting"
 	"github.com/stratosnet/sds/pp/types"
 )
@@ -61,6 +64,22 @@ type P2pServer struct {
 	// connMap client connection map
 	connMap map[string]*cf.ClientConn
 
+	// ppP2pAddresses the P2P addresses of the PPs, by network address
+	ppP2pAddresses *sync.Map
+	// quicDialer opens the QUIC streams to the PPs advertising a QUIC address, stored in ppQuicAddresses by P2P address
+	quicDialer      *core.QuicDialer
+	ppQuicAddresses *sync.Map
+	// muxDialer opens the other conns to the PPs as streams of a single TCP connection per PP
+	muxDialer *core.MuxDialer
+	// ppRelays the relays of the internal PPs, by P2P address
+	ppRelays *sync.Map
+	// ppHandshakeVersions the handshake versions advertised by the PPs, by network address
+	ppHandshakeVersions *sync.Map
+
+	// portMapping the port forwarded to this node by the gateway of its network, when it is internal
+	portMapping    *portMapping
+	portMappingJob clock.Job
+
 	clientMutex sync.Mutex
 
 	connContextKey []interface{}
@@ -104,6 +123,10 @@ func (p *P2pServer) StartListenServer(ctx context.Context, port string) {
 	}
 	spbServer := p.newServer(ctx)
 	p.server = spbServer
+	p.startQuicServer(spbServer)
+	if setting.RelayAddress != "" {
+		p.startRelayListener(spbServer)
+	}
 	utils.DebugLog("StartListenServer!!! ", port)
 	err = spbServer.Start(netListen)
 	if err != nil {
@@ -140,7 +163,12 @@ func (p *P2pServer) newServer(ctx context.Context) *core.Server {
 		core.LogOpenOption(true),
 		core.MinAppVersionOption(setting.Config.Version.MinAppVer),
 		core.P2pAddressOption(p.GetP2PAddress().String()),
+		core.P2pKeyOption(p.p2pPrivKey),
+		core.MinHandshakeVersionOption(setting.Config.Node.Connectivity.MinHandshakeVersion),
 		core.MaxConnectionsOption(maxConnections),
+		core.RelayCapacityOption(setting.Config.Node.Connectivity.RelayCapacity),
+		core.CompressionOption(setting.Config.Node.Connectivity.CompressionThreshold),
+		core.CaptureOption(capture.Message),
 		core.ContextKVOption(ckv),
 	)
 	server.SetVolRecOptions(
@@ -163,6 +191,17 @@ func (p *P2pServer) Start(ctx context.Context) {
 
 	ctx = p.initQuitChs(ctx)
 	setting.SetMyNetworkAddress()
+	p.setupConnectivity()
+	p.ppP2pAddresses = &sync.Map{}
+	p.ppRelays = &sync.Map{}
+	p.ppHandshakeVersions = &sync.Map{}
+	if setting.Config.Node.Connectivity.QuicPort != "" {
+		p.quicDialer = core.NewQuicDialer()
+		p.ppQuicAddresses = &sync.Map{}
+	}
+	if setting.Config.Node.Connectivity.Multiplexing {
+		p.muxDialer = core.NewMuxDialer(p.p2pPrivKey, p.GetP2PAddress().String())
+	}
 	go p.StartListenServer(ctx, setting.GetP2pServerPort())
 	p.initClient()
 }
@@ -175,6 +214,13 @@ func (p *P2pServer) Stop() {
 		}
 		p.server.Stop()
 	}
+	if p.quicDialer != nil {
+		p.quicDialer.Close()
+	}
+	if p.muxDialer != nil {
+		p.muxDialer.Close()
+	}
+	p.stopPortMapping()
 }
 
 func (p *P2pServer) initQuitChs(ctx context.Context) context.Context {
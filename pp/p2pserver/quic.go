@@ -0,0 +1,45 @@
+package p2pserver
+
+import (
+	"net"
+
+	"github.com/stratosnet/sds/framework/client/cf"
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/utils"
+
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+// startQuicServer serves the streams of the QUIC transport with the P2P server, like its TCP conns
+func (p *P2pServer) startQuicServer(server *core.Server) {
+	port := setting.Config.Node.Connectivity.QuicPort
+	if port == "" {
+		return
+	}
+	listener, err := core.ListenQuic(":" + port)
+	if err != nil {
+		utils.ErrorLog("StartQuicServer Error", err)
+		return
+	}
+	utils.DebugLog("StartQuicServer ", port)
+	go func() {
+		if err := server.Start(listener); err != nil {
+			utils.ErrorLog("StartQuicServer Error", err)
+		}
+	}()
+}
+
+// quicDialOption the option opening the conn to the PP as a QUIC stream, if both nodes have enabled QUIC
+func (p *P2pServer) quicDialOption(p2pAddress string) (cf.ClientOption, bool) {
+	if p.quicDialer == nil {
+		return nil, false
+	}
+	value, ok := p.ppQuicAddresses.Load(p2pAddress)
+	if !ok {
+		return nil, false
+	}
+	quicAddress := value.(string)
+	return cf.DialOption(func() (net.Conn, error) {
+		return p.quicDialer.Dial(quicAddress)
+	}), true
+}
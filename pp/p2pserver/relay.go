@@ -0,0 +1,156 @@
+package p2pserver
+
+import (
+	"math"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/alex023/clock"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/client/cf"
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/sds-msg/protos"
+
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const relayRetryInterval = 10 * time.Second
+
+var portMappingClock = clock.NewClock()
+
+// setupConnectivity makes an internal node reachable by the other PPs, through a port mapped by the gateway of its
+// network, or else through its relay peer
+func (p *P2pServer) setupConnectivity() {
+	connectivity := setting.Config.Node.Connectivity
+	if !connectivity.Internal {
+		return
+	}
+	if connectivity.PortMapping {
+		err := p.startPortMapping()
+		if err == nil {
+			return
+		}
+		utils.ErrorLog("port mapping failed", err.Error())
+	}
+	if connectivity.RelayPeer != "" {
+		setting.RelayAddress = connectivity.RelayPeer
+		utils.Log("reachable through relay peer", setting.RelayAddress)
+	} else {
+		utils.ErrorLog("the internal node can't be reached by the other resource nodes, set a relay peer")
+	}
+}
+
+// startPortMapping forwards the network port of the gateway to the P2P server, and renews the mapping until Stop
+func (p *P2pServer) startPortMapping() error {
+	internalPort, err := strconv.ParseUint(setting.GetP2pServerPort(), 10, 16)
+	if err != nil {
+		return errors.Wrap(err, "invalid P2P server port")
+	}
+	externalPort, err := strconv.ParseUint(setting.Config.Node.Connectivity.NetworkPort, 10, 16)
+	if err != nil {
+		return errors.Wrap(err, "invalid network port")
+	}
+	mapping, err := mapPort(uint16(internalPort), uint16(externalPort))
+	if err != nil {
+		return err
+	}
+	utils.Logf("%v gateway forwards port %v of %v", mapping.protocol, mapping.externalPort, mapping.externalIP)
+	setting.SetMappedNetworkAddress(mapping.externalIP, mapping.externalPort)
+
+	p.portMapping = mapping
+	p.portMappingJob, _ = portMappingClock.AddJobRepeat(portMappingRenewal, 0, func() {
+		if err := mapping.renew(); err != nil {
+			utils.ErrorLog("failed renewing the port mapping", err.Error())
+		}
+	})
+	return nil
+}
+
+func (p *P2pServer) stopPortMapping() {
+	if p.portMappingJob != nil {
+		p.portMappingJob.Cancel()
+	}
+	if p.portMapping != nil {
+		p.portMapping.remove()
+	}
+}
+
+// startRelayListener keeps a relay listen conn to the relay peer, so the server is reached through it
+func (p *P2pServer) startRelayListener(server *core.Server) {
+	relayAddress := setting.RelayAddress
+	go func() {
+		for {
+			err := server.ListenRelay(relayAddress)
+			if errors.Is(err, utils.ErrServerClosed) {
+				return
+			}
+			utils.ErrorLog("relay listener", err)
+			time.Sleep(relayRetryInterval)
+		}
+	}()
+}
+
+// StorePpAddresses remembers how a PP can be reached, when it advertises a QUIC address or a relay. The routes are kept
+// by P2P address, and the conns opened to the network address of the PP check that the PP proves this P2P address with
+// at least the handshake version it advertises
+func (p *P2pServer) StorePpAddresses(ppInfo *protos.PPBaseInfo) {
+	if ppInfo == nil || ppInfo.P2PAddress == "" {
+		return
+	}
+	p.ppP2pAddresses.Store(ppInfo.NetworkAddress, ppInfo.P2PAddress)
+	if ppInfo.HandshakeVersion > 0 {
+		p.ppHandshakeVersions.Store(ppInfo.NetworkAddress, ppInfo.HandshakeVersion)
+	}
+	if ppInfo.RelayAddress != "" {
+		p.ppRelays.Store(ppInfo.P2PAddress, ppInfo.RelayAddress)
+	} else {
+		p.ppRelays.Delete(ppInfo.P2PAddress)
+	}
+	if p.quicDialer != nil && ppInfo.QuicAddress != "" {
+		p.ppQuicAddresses.Store(ppInfo.P2PAddress, ppInfo.QuicAddress)
+	}
+}
+
+// getPpP2pAddress the P2P address of the PP at the network address, if it is known
+func (p *P2pServer) getPpP2pAddress(networkAddress string) (string, bool) {
+	value, ok := p.ppP2pAddresses.Load(networkAddress)
+	if !ok {
+		return "", false
+	}
+	return value.(string), true
+}
+
+// peerHandshakeVersion the handshake version advertised by the SP or the PP at the network address, 0 when unknown
+func (p *P2pServer) peerHandshakeVersion(networkAddress string, spconn bool) uint8 {
+	var version uint32
+	if spconn {
+		setting.SPMap.Range(func(_, value any) bool {
+			if sp := value.(setting.SPBaseInfo); sp.NetworkAddress == networkAddress {
+				version = sp.HandshakeVersion
+				return false
+			}
+			return true
+		})
+	} else if value, ok := p.ppHandshakeVersions.Load(networkAddress); ok {
+		version = value.(uint32)
+	}
+	if version > math.MaxUint8 {
+		return math.MaxUint8
+	}
+	return uint8(version)
+}
+
+// relayDialOption the option opening the conn to an internal PP through its relay
+func (p *P2pServer) relayDialOption(p2pAddress string) (cf.ClientOption, bool) {
+	value, ok := p.ppRelays.Load(p2pAddress)
+	if !ok {
+		return nil, false
+	}
+	relayAddress := value.(string)
+	return cf.DialOption(func() (net.Conn, error) {
+		return core.DialRelay(relayAddress, p2pAddress)
+	}), true
+}
@@ -45,6 +45,9 @@ func (p *P2pServer) GetPPInfo() *protos.PPBaseInfo {
 		BeneficiaryAddress: setting.BeneficiaryAddress,
 		NetworkAddress:     setting.NetworkAddress,
 		RestAddress:        setting.RestAddress,
+		QuicAddress:        setting.QuicAddress,
+		RelayAddress:       setting.RelayAddress,
+		HandshakeVersion:   uint32(core.LatestHandshakeVersion),
 	}
 }
 
@@ -111,8 +114,11 @@ func (p *P2pServer) SendMessage(ctx context.Context, conn core.WriteCloser, pb p
 		return conn.Write(msgBuf, ctx)
 	case *cf.ClientConn:
 		return conn.Write(msgBuf, ctx)
-	default:
+	case nil:
 		return errors.New("unknown connection type")
+	default:
+		// eg. the conn of the messages replayed from a capture
+		return conn.Write(msgBuf, ctx)
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/stratosnet/sds/framework/crypto"
 	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/file"
 	"github.com/stratosnet/sds/pp/setting"
 	"github.com/stratosnet/sds/rpc"
 )
@@ -22,6 +23,7 @@ const (
 	MSG_GET_DIST_USAGE_RESPONSE   = "monitor_getDiskUsage"
 	MSG_GET_ONLINE_STATE          = "monitor_getOnlineState"
 	MSG_GET_NODE_DETAILS          = "monitor_getNodeDetails"
+	MSG_GET_SCRUB_STATUS          = "monitor_getScrubStatus"
 )
 
 type DiskUsage struct {
@@ -44,12 +46,13 @@ type NodeDetails struct {
 }
 
 type MonitorResult struct {
-	Return      string         `json:"return"`
-	MessageType string         `json:"message_type"`
-	TrafficInfo *[]TrafficInfo `json:"traffic_info,omitempty"`
-	OnlineState *OnlineState   `json:"online_state,omitempty"`
-	DiskUsage   *DiskUsage     `json:"disk_usage,omitempty"`
-	NodeDetails *NodeDetails   `json:"node_details,omitempty"`
+	Return      string            `json:"return"`
+	MessageType string            `json:"message_type"`
+	TrafficInfo *[]TrafficInfo    `json:"traffic_info,omitempty"`
+	OnlineState *OnlineState      `json:"online_state,omitempty"`
+	DiskUsage   *DiskUsage        `json:"disk_usage,omitempty"`
+	NodeDetails *NodeDetails      `json:"node_details,omitempty"`
+	ScrubStatus *file.ScrubStatus `json:"scrub_status,omitempty"`
 }
 
 type MonitorNotificationResult struct {
@@ -224,6 +227,19 @@ func (api *monitorApi) GetNodeDetails(ctx context.Context, param ParamMonitor) (
 	}, nil
 }
 
+// GetScrubStatus progress of the last verification of the stored slices
+func (api *monitorApi) GetScrubStatus(ctx context.Context, param ParamMonitor) (*MonitorResult, error) {
+	if _, found := subscribedIds.Load(param.SubId); !found {
+		return nil, errors.New("client hasn't subscribed to the service")
+	}
+	status := file.GetScrubStatus()
+	return &MonitorResult{
+		Return:      "0",
+		MessageType: MSG_GET_SCRUB_STATUS,
+		ScrubStatus: &status,
+	}, nil
+}
+
 // Subscription client calls the method monitor_subscribe with this function as the parameter
 func (api *monitorApi) Subscription(ctx context.Context, token string) (*rpc.Subscription, error) {
 	if !verifyToken(token) {
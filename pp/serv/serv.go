@@ -11,6 +11,8 @@ import (
 	"github.com/stratosnet/sds/pp/account"
 	"github.com/stratosnet/sds/pp/api"
 	"github.com/stratosnet/sds/pp/api/rest"
+	"github.com/stratosnet/sds/pp/api/s3gateway"
+	"github.com/stratosnet/sds/pp/capture"
 	"github.com/stratosnet/sds/pp/event"
 	"github.com/stratosnet/sds/pp/file"
 	"github.com/stratosnet/sds/pp/metrics"
@@ -18,6 +20,7 @@ import (
 	"github.com/stratosnet/sds/pp/network"
 	"github.com/stratosnet/sds/pp/p2pserver"
 	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/pp/tracing"
 	"github.com/stratosnet/sds/pp/types"
 	"github.com/stratosnet/sds/rpc"
 	"github.com/stratosnet/sds/utils/environment"
@@ -30,6 +33,7 @@ type BaseServer struct {
 	ipcServ     *namespace.IpcServer
 	httpRpcServ *namespace.HttpServer
 	monitorServ *namespace.HttpServer
+	s3Gateway   *s3gateway.Gateway
 }
 
 func (bs *BaseServer) Start() error {
@@ -46,11 +50,31 @@ func (bs *BaseServer) Start() error {
 		return err
 	}
 
+	err = bs.startSliceStore()
+	if err != nil {
+		return err
+	}
+
+	err = bs.startCapture()
+	if err != nil {
+		return err
+	}
+
 	err = bs.startP2pServer()
 	if err != nil {
 		return err
 	}
 
+	err = bs.startTracing()
+	if err != nil {
+		return err
+	}
+
+	err = bs.startResumeUploadTasks()
+	if err != nil {
+		return err
+	}
+
 	err = bs.startInternalApiServer()
 	if err != nil {
 		return err
@@ -61,6 +85,11 @@ func (bs *BaseServer) Start() error {
 		return err
 	}
 
+	err = bs.startS3Gateway()
+	if err != nil {
+		return err
+	}
+
 	err = bs.startTrafficLog()
 	if err != nil {
 		return err
@@ -76,6 +105,16 @@ func (bs *BaseServer) Start() error {
 		return err
 	}
 
+	err = bs.startScrubSliceJob()
+	if err != nil {
+		return err
+	}
+
+	err = bs.startBandwidthScheduleJob()
+	if err != nil {
+		return err
+	}
+
 	err = bs.startIPC()
 	if err != nil {
 		return err
@@ -201,6 +240,27 @@ func (bs *BaseServer) startRamMonitor() error {
 	return nil
 }
 
+func (bs *BaseServer) startSliceStore() error {
+	if err := file.InitSliceStore(); err != nil {
+		return errors.Wrap(err, "failed init slice store")
+	}
+	return nil
+}
+
+func (bs *BaseServer) startCapture() error {
+	if err := capture.Start(); err != nil {
+		return errors.Wrap(err, "failed init capture")
+	}
+	return nil
+}
+
+func (bs *BaseServer) startTracing() error {
+	if err := tracing.Initialize(bs.p2pServ.GetP2PAddress().String()); err != nil {
+		return errors.Wrap(err, "failed init tracing")
+	}
+	return nil
+}
+
 func (bs *BaseServer) startP2pServer() error {
 	bs.p2pServ = &p2pserver.P2pServer{}
 	if err := bs.p2pServ.Init(); err != nil {
@@ -228,6 +288,14 @@ func (bs *BaseServer) startP2pServer() error {
 	return nil
 }
 
+func (bs *BaseServer) startResumeUploadTasks() error {
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, bs.p2pServ)
+	ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, bs.ppNetwork)
+	event.ResumeUploadTasks(ctx)
+	return nil
+}
+
 func (bs *BaseServer) startTrafficLog() error {
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, bs.p2pServ)
@@ -250,6 +318,19 @@ func (bs *BaseServer) startReportTransferFailureJob() error {
 	return nil
 }
 
+func (bs *BaseServer) startScrubSliceJob() error {
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, bs.p2pServ)
+	ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, bs.ppNetwork)
+	event.StartScrubSliceJob(ctx)
+	return nil
+}
+
+func (bs *BaseServer) startBandwidthScheduleJob() error {
+	setting.StartBandwidthScheduleJob()
+	return nil
+}
+
 func (bs *BaseServer) startInternalApiServer() error {
 	if setting.Config.Keys.WalletAddress != "" && setting.Config.Streaming.InternalPort != "" {
 		ctx := context.Background()
@@ -274,6 +355,22 @@ func (bs *BaseServer) startRestServer() error {
 	return nil
 }
 
+func (bs *BaseServer) startS3Gateway() error {
+	if !setting.Config.S3Gateway.Enabled {
+		return nil
+	}
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, types.P2P_SERVER_KEY, bs.p2pServ)
+	ctx = context.WithValue(ctx, types.PP_NETWORK_KEY, bs.ppNetwork)
+	gateway, err := s3gateway.StartGateway(ctx, setting.Config.S3Gateway)
+	if err != nil {
+		utils.ErrorLog("failed starting the s3 gateway", err)
+		return nil
+	}
+	bs.s3Gateway = gateway
+	return nil
+}
+
 func (bs *BaseServer) Stop() {
 	utils.DebugLogf("BaseServer.Stop ... ")
 	if bs.ipcServ != nil {
@@ -285,11 +382,19 @@ func (bs *BaseServer) Stop() {
 	if bs.monitorServ != nil {
 		bs.monitorServ.Stop()
 	}
+	if bs.s3Gateway != nil {
+		bs.s3Gateway.Stop()
+	}
 	if bs.p2pServ != nil {
 		bs.p2pServ.Stop()
 	}
 	StopDumpTrafficLog()
 	file.StopClearTmpFileJob()
 	event.StopReportTransferFailureJob()
+	event.StopScrubSliceJob()
+	setting.StopBandwidthScheduleJob()
+	_ = file.CloseSliceStore()
+	tracing.Shutdown()
+	capture.Stop()
 	// TODO: stop IPC, TrafficLog, InternalApiServer, RestServer
 }
@@ -2,6 +2,8 @@ package serv
 
 import (
 	"context"
+	"crypto/ed25519"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -14,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stratosnet/sds/framework/core"
 	"github.com/stratosnet/sds/framework/crypto"
+	"github.com/stratosnet/sds/framework/crypto/encryption"
 	fwtypes "github.com/stratosnet/sds/framework/types"
 	"github.com/stratosnet/sds/framework/utils"
 	msgtypes "github.com/stratosnet/sds/sds-msg/types"
@@ -463,8 +466,11 @@ func (api *terminalCmd) Upload(ctx context.Context, param []string) (CmdResult,
 	}
 
 	isEncrypted := false
+	var contentKey []byte
 	desiredTier := uint32(DefaultDesiredUploadTier)
 	allowHigherTier := true
+	recursive := false
+	concurrency := event.DEFAULT_DIRECTORY_UPLOAD_CONCURRENCY
 
 	if len(param) > 1 {
 		for _, p := range param[1:] {
@@ -479,6 +485,11 @@ func (api *terminalCmd) Upload(ctx context.Context, param []string) (CmdResult,
 				if err != nil {
 					return CmdResult{Msg: ""}, errors.Errorf("invalid param --isEncrypted. Should be true or false: %v ", err.Error())
 				}
+			case "--encryptionKey":
+				contentKey, err = parseContentKey(kv[1])
+				if err != nil {
+					return CmdResult{Msg: ""}, err
+				}
 			case "--nodeTier":
 				tier, err := strconv.ParseUint(kv[1], 10, 32)
 				if err != nil {
@@ -493,6 +504,19 @@ func (api *terminalCmd) Upload(ctx context.Context, param []string) (CmdResult,
 				if err != nil {
 					return CmdResult{Msg: ""}, errors.Errorf("invalid param --allowHigherTier. Should be true or false: %v ", err.Error())
 				}
+			case "--recursive":
+				recursive, err = strconv.ParseBool(kv[1])
+				if err != nil {
+					return CmdResult{Msg: ""}, errors.Errorf("invalid param --recursive. Should be true or false: %v ", err.Error())
+				}
+			case "--concurrency":
+				concurrency, err = strconv.Atoi(kv[1])
+				if err != nil {
+					return CmdResult{Msg: ""}, errors.Errorf("invalid param --concurrency. Should be an integer: %v ", err.Error())
+				}
+				if concurrency <= 0 || concurrency > event.MAX_DIRECTORY_UPLOAD_CONCURRENCY {
+					return CmdResult{Msg: ""}, errors.Errorf("invalid param --concurrency. Should be between 1 and %v", event.MAX_DIRECTORY_UPLOAD_CONCURRENCY)
+				}
 			default:
 				return CmdResult{Msg: ""}, errors.Errorf("invalid param %v.", kv[0])
 			}
@@ -500,11 +524,38 @@ func (api *terminalCmd) Upload(ctx context.Context, param []string) (CmdResult,
 	}
 
 	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
-	event.RequestUploadFile(ctx, pathStr, isEncrypted, false, desiredTier, allowHigherTier,
+	if recursive {
+		isFile, err := file.IsFile(pathStr)
+		if err != nil {
+			return CmdResult{Msg: ""}, err
+		}
+		if isFile {
+			return CmdResult{Msg: ""}, errors.New("the provided path indicates a file, not a directory")
+		}
+		go func() {
+			if _, err := event.RequestUploadDirectory(ctx, pathStr, isEncrypted, contentKey, desiredTier, allowHigherTier, concurrency); err != nil {
+				pp.ErrorLog(ctx, "failed uploading directory: ", err)
+			}
+		}()
+		return CmdResult{Msg: DefaultMsg}, nil
+	}
+	event.RequestUploadFile(ctx, pathStr, isEncrypted, contentKey, false, desiredTier, allowHigherTier,
 		setting.WalletAddress, setting.WalletPublicKey.Bytes(), nil)
 	return CmdResult{Msg: DefaultMsg}, nil
 }
 
+// parseContentKey the content key of an encrypted upload, either given in hex or "random"
+func parseContentKey(value string) ([]byte, error) {
+	if value == "random" {
+		return encryption.GenerateContentKey()
+	}
+	contentKey, err := hex.DecodeString(value)
+	if err != nil || len(contentKey) != encryption.ContentKeySize {
+		return nil, errors.Errorf("invalid param --encryptionKey. Should be \"random\" or %v bytes in hex", encryption.ContentKeySize)
+	}
+	return contentKey, nil
+}
+
 func (api *terminalCmd) UploadStream(ctx context.Context, param []string) (CmdResult, error) {
 	terminalId, param, err := getTerminalIdFromParam(param)
 	if err != nil {
@@ -552,7 +603,7 @@ func (api *terminalCmd) UploadStream(ctx context.Context, param []string) (CmdRe
 
 	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
 	ctx = core.RegisterRemoteReqId(ctx, uuid.New().String())
-	event.RequestUploadFile(ctx, pathStr, false, true, desiredTier, allowHigherTier,
+	event.RequestUploadFile(ctx, pathStr, false, nil, true, desiredTier, allowHigherTier,
 		setting.WalletAddress, setting.WalletPublicKey.Bytes(), nil)
 	return CmdResult{Msg: DefaultMsg}, nil
 }
@@ -632,8 +683,34 @@ func (api *terminalCmd) Download(ctx context.Context, param []string) (CmdResult
 		return CmdResult{}, errors.New("input download path, e.g: sdm://account_address/file_hash|filename(optional)")
 	}
 	saveAs := ""
-	if len(param) == 2 {
-		saveAs = param[1]
+	recursive := false
+	concurrency := event.DEFAULT_DIRECTORY_UPLOAD_CONCURRENCY
+	for _, p := range param[1:] {
+		if !strings.HasPrefix(p, "--") {
+			saveAs = p
+			continue
+		}
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			return CmdResult{Msg: ""}, errors.Errorf("invalid param %v.", p)
+		}
+		switch kv[0] {
+		case "--recursive":
+			recursive, err = strconv.ParseBool(kv[1])
+			if err != nil {
+				return CmdResult{Msg: ""}, errors.Errorf("invalid param --recursive. Should be true or false: %v ", err.Error())
+			}
+		case "--concurrency":
+			concurrency, err = strconv.Atoi(kv[1])
+			if err != nil {
+				return CmdResult{Msg: ""}, errors.Errorf("invalid param --concurrency. Should be an integer: %v ", err.Error())
+			}
+			if concurrency <= 0 || concurrency > event.MAX_DIRECTORY_UPLOAD_CONCURRENCY {
+				return CmdResult{Msg: ""}, errors.Errorf("invalid param --concurrency. Should be between 1 and %v", event.MAX_DIRECTORY_UPLOAD_CONCURRENCY)
+			}
+		default:
+			return CmdResult{Msg: ""}, errors.Errorf("invalid param %v.", kv[0])
+		}
 	}
 
 	_, ownerWalletAddress, fileHash, _, err := fwtypes.ParseFileHandle(param[0])
@@ -651,6 +728,15 @@ func (api *terminalCmd) Download(ctx context.Context, param []string) (CmdResult
 	}
 
 	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	if recursive {
+		// the handle is the manifest of a directory, the whole directory tree is restored
+		go func() {
+			if _, err := event.RequestDownloadDirectory(ctx, param[0], concurrency); err != nil {
+				pp.ErrorLog(ctx, "failed restoring directory: ", err)
+			}
+		}()
+		return CmdResult{Msg: DefaultMsg}, nil
+	}
 	core.RegisterReqId(ctx, task.LOCAL_REQID)
 	nowSec := time.Now().Unix()
 	if task.CheckDownloadTask(fileHash, setting.WalletAddress, task.LOCAL_REQID) {
@@ -820,13 +906,13 @@ func (api *terminalCmd) ShareFile(ctx context.Context, param []string) (CmdResul
 	}
 
 	ipfsCid := ""
+	var recipients []event.ShareRecipient
 	utils.DebugLog("len of param:", len(param))
-	if len(param) == 4 {
-		p3 := param[3]
-		if !strings.Contains(p3, "=") {
-			return CmdResult{Msg: ""}, errors.Errorf("invalid param %v.", p3)
+	for _, p := range param[3:] {
+		if !strings.Contains(p, "=") {
+			return CmdResult{Msg: ""}, errors.Errorf("invalid param %v.", p)
 		}
-		kv := strings.SplitN(p3, "=", 2)
+		kv := strings.SplitN(p, "=", 2)
 		switch kv[0] {
 		case "--ipfsCid":
 			_, err := cid2.Decode(kv[1])
@@ -834,6 +920,12 @@ func (api *terminalCmd) ShareFile(ctx context.Context, param []string) (CmdResul
 				return CmdResult{Msg: ""}, errors.Errorf("wrong length param %v.", kv[0])
 			}
 			ipfsCid = kv[1]
+		case "--recipient":
+			recipient, err := parseShareRecipient(kv[1])
+			if err != nil {
+				return CmdResult{Msg: ""}, err
+			}
+			recipients = append(recipients, recipient)
 		default:
 			return CmdResult{Msg: ""}, errors.Errorf("invalid param %v.", kv[0])
 		}
@@ -843,10 +935,22 @@ func (api *terminalCmd) ShareFile(ctx context.Context, param []string) (CmdResul
 	nowSec := time.Now().Unix()
 	// sign the wallet signature by wallet private key
 	wsignMsg := msgutils.ShareFileWalletSignMessage(fileHash, setting.WalletAddress, nowSec)
+	if len(recipients) > 0 {
+		wsignMsg = msgutils.ShareFileToRecipientsWalletSignMessage(fileHash, setting.WalletAddress,
+			event.ShareRecipientAddresses(recipients), nowSec)
+	}
 	wsign, err := setting.WalletPrivateKey.Sign([]byte(wsignMsg))
 	if err != nil {
 		return CmdResult{Msg: ""}, errors.New("wallet failed to sign message")
 	}
+	if len(recipients) > 0 {
+		err = event.ReqShareFileToRecipients(ctx, fileHash, setting.WalletAddress, int64(shareDuration), isPrivate, recipients,
+			setting.WalletPublicKey.Bytes(), wsign, nowSec, ipfsCid)
+		if err != nil {
+			return CmdResult{Msg: ""}, err
+		}
+		return CmdResult{Msg: DefaultMsg}, nil
+	}
 	event.ReqShareFile(ctx, param[0], "", setting.WalletAddress, int64(shareDuration), isPrivate, setting.WalletPublicKey.Bytes(), wsign, nowSec, ipfsCid)
 	// if len(str1) == setting.FILEHASHLEN { //
 	// 	event.ReqShareFile("", str1, "", int64(time), isPrivate, nil)
@@ -855,6 +959,25 @@ func (api *terminalCmd) ShareFile(ctx context.Context, param []string) (CmdResul
 	return CmdResult{Msg: DefaultMsg}, nil
 }
 
+// parseShareRecipient parses <wallet address>[:<encryption public key>], the key being in hex
+func parseShareRecipient(value string) (event.ShareRecipient, error) {
+	recipient := event.ShareRecipient{}
+	walletAddress, pubkey, found := strings.Cut(value, ":")
+	if _, err := fwtypes.WalletAddressFromBech32(walletAddress); err != nil {
+		return recipient, errors.Errorf("invalid param --recipient. %v isn't a wallet address", walletAddress)
+	}
+	recipient.WalletAddress = walletAddress
+	if !found {
+		return recipient, nil
+	}
+	publicKey, err := hex.DecodeString(pubkey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return recipient, errors.New("invalid param --recipient. The encryption public key should be in hex")
+	}
+	recipient.EncryptionPubkey = publicKey
+	return recipient, nil
+}
+
 func (api *terminalCmd) AllShare(ctx context.Context, param []string) (CmdResult, error) {
 	terminalId, param, err := getTerminalIdFromParam(param)
 	if err != nil {
@@ -923,11 +1046,23 @@ func (api *terminalCmd) GetShareFile(ctx context.Context, param []string) (CmdRe
 	if err != nil {
 		return CmdResult{Msg: ""}, err
 	}
+	if err = event.SaveShareLinkRecipients(shareLink.Link, shareLink.RecipientKeys, setting.WalletAddress); err != nil {
+		return CmdResult{Msg: ""}, err
+	}
 	event.GetShareFile(ctx, shareLink.Link, shareLink.Password, "", setting.WalletAddress, setting.WalletPublicKey.Bytes(), nil, nowSec)
 
 	return CmdResult{Msg: DefaultMsg}, nil
 }
 
+// EncryptionPubkey the key that owners of encrypted files use to share them with this wallet
+func (api *terminalCmd) EncryptionPubkey(_ context.Context, _ []string) (CmdResult, error) {
+	publicKey, err := file.GetWalletEncryptionPublicKey()
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+	return CmdResult{Msg: setting.WalletAddress + ":" + hex.EncodeToString(publicKey)}, nil
+}
+
 func (api *terminalCmd) PauseGet(ctx context.Context, param []string) (CmdResult, error) {
 	terminalId, param, err := getTerminalIdFromParam(param)
 	if err != nil {
@@ -1020,6 +1155,170 @@ func (api *terminalCmd) Maintenance(ctx context.Context, param []string) (CmdRes
 	return CmdResult{Msg: DefaultMsg}, nil
 }
 
+func (api *terminalCmd) Scrub(ctx context.Context, param []string) (CmdResult, error) {
+	terminalId, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+
+	action := "status"
+	if len(param) > 0 {
+		action = param[0]
+	}
+	switch action {
+	case "start":
+		if file.GetScrubStatus().Running {
+			return CmdResult{Msg: ""}, errors.New("slices are already being verified")
+		}
+		ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+		go func() {
+			if err := event.ScrubSlices(ctx); err != nil {
+				pp.ErrorLog(ctx, "failed verifying the stored slices", err.Error())
+				return
+			}
+			pp.Log(ctx, "finished verifying the stored slices")
+		}()
+		return CmdResult{Msg: DefaultMsg}, nil
+	case "stop":
+		if !file.StopSliceScrub() {
+			return CmdResult{Msg: ""}, errors.New("slices are not being verified")
+		}
+		return CmdResult{Msg: DefaultMsg}, nil
+	case "status":
+		return CmdResult{Msg: formatScrubStatus(file.GetScrubStatus())}, nil
+	default:
+		return CmdResult{Msg: ""}, errors.New("first parameter should be either 'start', 'stop' or 'status'")
+	}
+}
+
+func formatScrubStatus(status file.ScrubStatus) string {
+	if status.StartTime == 0 {
+		return "the stored slices were not verified yet"
+	}
+	state := "finished at " + time.Unix(status.EndTime, 0).Format(time.RFC3339)
+	if status.Running {
+		state = "running"
+	}
+	msg := fmt.Sprintf("started at %v, %v\nchecked: %v, skipped (unknown file): %v, corrupted: %v, bytes read: %v",
+		time.Unix(status.StartTime, 0).Format(time.RFC3339), state, status.Checked, status.Skipped, status.Corrupted, status.BytesRead)
+	if status.LastError != "" {
+		msg += "\nerror: " + status.LastError
+	}
+	for _, sliceHash := range status.Quarantined {
+		msg += "\nquarantined: " + sliceHash
+	}
+	return msg
+}
+
+func (api *terminalCmd) PutVersion(ctx context.Context, param []string) (CmdResult, error) {
+	terminalId, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+	if len(param) != 2 {
+		return CmdResult{Msg: ""}, errors.New("input the object key and the path of the file")
+	}
+	key, filePath := param[0], param[1]
+	if err = file.ValidateObjectKey(key); err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+
+	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	go func() {
+		version, err := event.PutObjectVersion(ctx, key, filePath, DefaultDesiredUploadTier, true)
+		if err != nil {
+			pp.ErrorLog(ctx, "failed uploading the new version: ", err)
+			return
+		}
+		pp.Log(ctx, formatObjectVersion(version))
+	}()
+	return CmdResult{Msg: DefaultMsg}, nil
+}
+
+func (api *terminalCmd) Versions(ctx context.Context, param []string) (CmdResult, error) {
+	terminalId, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+	if len(param) != 1 {
+		return CmdResult{Msg: ""}, errors.New("input the object key")
+	}
+
+	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	go func() {
+		manifest, err := event.GetObjectVersions(ctx, param[0])
+		if err != nil {
+			pp.ErrorLog(ctx, "failed listing the versions: ", err)
+			return
+		}
+		for i := range manifest.Versions {
+			pp.Log(ctx, formatObjectVersion(&manifest.Versions[i]))
+		}
+	}()
+	return CmdResult{Msg: DefaultMsg}, nil
+}
+
+func (api *terminalCmd) GetVersion(ctx context.Context, param []string) (CmdResult, error) {
+	terminalId, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+	if len(param) != 1 && len(param) != 2 {
+		return CmdResult{Msg: ""}, errors.New("input the object key, and the version number (latest if omitted)")
+	}
+	number := uint64(0)
+	if len(param) == 2 {
+		if number, err = strconv.ParseUint(param[1], 10, 64); err != nil {
+			return CmdResult{Msg: ""}, errors.New("invalid version number")
+		}
+	}
+
+	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	go func() {
+		path, err := event.DownloadObjectVersion(ctx, param[0], number)
+		if err != nil {
+			pp.ErrorLog(ctx, "failed downloading the version: ", err)
+			return
+		}
+		pp.Log(ctx, "version downloaded to", path)
+	}()
+	return CmdResult{Msg: DefaultMsg}, nil
+}
+
+func (api *terminalCmd) Rollback(ctx context.Context, param []string) (CmdResult, error) {
+	terminalId, param, err := getTerminalIdFromParam(param)
+	if err != nil {
+		return CmdResult{Msg: ""}, err
+	}
+	if len(param) != 2 {
+		return CmdResult{Msg: ""}, errors.New("input the object key and the version number to roll back to")
+	}
+	number, err := strconv.ParseUint(param[1], 10, 64)
+	if err != nil || number == 0 {
+		return CmdResult{Msg: ""}, errors.New("invalid version number")
+	}
+
+	ctx = pp.CreateReqIdAndRegisterRpcLogger(ctx, terminalId)
+	go func() {
+		version, err := event.RollbackObjectVersion(ctx, param[0], number, DefaultDesiredUploadTier, true)
+		if err != nil {
+			pp.ErrorLog(ctx, "failed rolling back: ", err)
+			return
+		}
+		pp.Log(ctx, formatObjectVersion(version))
+	}()
+	return CmdResult{Msg: DefaultMsg}, nil
+}
+
+func formatObjectVersion(version *file.ObjectVersion) string {
+	msg := fmt.Sprintf("version %v: %v (%v, %v bytes) at %v", version.Number, version.FileHandle, version.FileName,
+		version.FileSize, time.Unix(version.Timestamp, 0).Format(time.RFC3339))
+	if version.RollbackOf != 0 {
+		msg += fmt.Sprintf(", rollback to version %v", version.RollbackOf)
+	}
+	return msg
+}
+
 func (api *terminalCmd) Replica(ctx context.Context, param []string) (CmdResult, error) {
 	terminalId, param, err := getTerminalIdFromParam(param)
 	if err != nil {
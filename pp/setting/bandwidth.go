@@ -0,0 +1,99 @@
+package setting
+
+import (
+	"time"
+
+	"github.com/alex023/clock"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/core"
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const bandwidthScheduleInterval = time.Minute
+
+var (
+	bandwidthClock = clock.NewClock()
+	bandwidthJob   clock.Job
+)
+
+// ApplyBandwidthLimits sets the bandwidth limits of the traffic config, with the rates of the current schedule if any
+func ApplyBandwidthLimits() error {
+	traffic := Config.Traffic
+	download, upload := traffic.MaxDownloadRate, traffic.MaxUploadRate
+	schedule, err := currentBandwidthSchedule(traffic.Schedules, time.Now())
+	if err != nil {
+		return err
+	}
+	if schedule != nil {
+		download, upload = schedule.MaxDownloadRate, schedule.MaxUploadRate
+	}
+	core.SetBandwidthLimits(download*1024, upload*1024, traffic.MaxPeerDownloadRate*1024, traffic.MaxPeerUploadRate*1024)
+	return nil
+}
+
+// migrateTrafficConfig moves the max_download_rate and max_upload_rate of the older configs, which were limits of each
+// connection in messages/sec (1 message ≈ 1KB), to the per peer rates in KB/sec. The node-wide rates are left unlimited
+func migrateTrafficConfig() error {
+	traffic := &Config.Traffic
+	if traffic.LegacyMaxDownloadRate == 0 && traffic.LegacyMaxUploadRate == 0 {
+		return nil
+	}
+	if traffic.MaxPeerDownloadRate == 0 {
+		traffic.MaxPeerDownloadRate = traffic.LegacyMaxDownloadRate
+	}
+	if traffic.MaxPeerUploadRate == 0 {
+		traffic.MaxPeerUploadRate = traffic.LegacyMaxUploadRate
+	}
+	utils.Logf("moved the per connection max_download_rate %v and max_upload_rate %v to max_peer_download_rate %v and max_peer_upload_rate %v",
+		traffic.LegacyMaxDownloadRate, traffic.LegacyMaxUploadRate, traffic.MaxPeerDownloadRate, traffic.MaxPeerUploadRate)
+	traffic.LegacyMaxDownloadRate, traffic.LegacyMaxUploadRate = 0, 0
+	return FlushConfig()
+}
+
+// currentBandwidthSchedule the first schedule including the time of the day, or nil
+func currentBandwidthSchedule(schedules []BandwidthSchedule, now time.Time) (*BandwidthSchedule, error) {
+	minute := now.Hour()*60 + now.Minute()
+	for i, schedule := range schedules {
+		start, err := parseScheduleTime(schedule.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseScheduleTime(schedule.End)
+		if err != nil {
+			return nil, err
+		}
+		// a schedule ending before it starts ends on the next day
+		if (start <= end && minute >= start && minute < end) || (start > end && (minute >= start || minute < end)) {
+			return &schedules[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// parseScheduleTime the minute of the day of a HH:MM time
+func parseScheduleTime(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, errors.Errorf("invalid bandwidth schedule time [%v], it should be HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// StartBandwidthScheduleJob applies the limits of the schedules when their hours begin and end. The schedules are read
+// when the config is loaded, edit them in the config file and restart the node to change them
+func StartBandwidthScheduleJob() {
+	utils.Log("Starting BandwidthScheduleJob......")
+	bandwidthJob, _ = bandwidthClock.AddJobRepeat(bandwidthScheduleInterval, 0, func() {
+		if err := ApplyBandwidthLimits(); err != nil {
+			utils.ErrorLog("failed applying the bandwidth schedules", err.Error())
+		}
+	})
+}
+
+func StopBandwidthScheduleJob() {
+	if bandwidthJob != nil {
+		utils.Log("Stopping BandwidthScheduleJob......")
+		bandwidthJob.Cancel()
+	}
+}
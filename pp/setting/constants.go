@@ -15,7 +15,7 @@ const (
 	HDPath          = "m/44'/606'/0'/0/0"
 	HDPathP2p       = "m/44'/606'/0/0"
 	Bip39Passphrase = ""
-	P2pServerType   = "tcp4"
+	P2pServerType   = "tcp" // dual-stack, IPv4 and IPv6
 
 	NodeReportIntervalSec         = 5 * 60       // Interval of node stat report, in seconds
 	PpLatencyCheckInterval        = 60 * 60 * 24 // interval for checking the latency peer PPs, in seconds
@@ -2,6 +2,8 @@ package setting
 
 import (
 	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	externalip "github.com/glendc/go-external-ip"
@@ -33,6 +35,12 @@ var NetworkIP net.IP
 
 var RestAddress string
 
+// QuicAddress the address of the QUIC transport advertised to the other nodes, empty when it is disabled
+var QuicAddress string
+
+// RelayAddress the network address of the relay advertised by an internal node, empty when it can be reached directly
+var RelayAddress string
+
 var MonitorInitialToken string
 
 // SetMyNetworkAddress set the PP's NetworkAddress according to the internal/external config in config file and the network config from OS
@@ -51,15 +59,9 @@ func SetMyNetworkAddress() {
 		if err != nil {
 			utils.ErrorLog(utils.FormatError(err))
 		}
-		for _, address := range addrs {
-			if ipnet, ok := address.(*net.IPNet); ok && !ipnet.IP.IsLoopback() {
-				if ipnet.IP.To4() != nil {
-					netAddr = ipnet.IP.String()
-				}
-			}
-		}
+		netAddr = interfaceNetworkAddress(addrs)
 	} else {
-		netAddr = Config.Node.Connectivity.NetworkAddress
+		netAddr = trimIPv6Brackets(Config.Node.Connectivity.NetworkAddress)
 		if netAddr == "" {
 			consensus := externalip.DefaultConsensus(&externalip.ConsensusConfig{Timeout: 10 * time.Second}, nil)
 			ip, err := consensus.ExternalIP()
@@ -84,8 +86,43 @@ func SetMyNetworkAddress() {
 		}
 		NetworkIP = ipList[0]
 	}
-	NetworkAddress = NetworkIP.String() + ":" + Config.Node.Connectivity.NetworkPort
-	RestAddress = NetworkIP.String() + ":" + Config.Streaming.RestPort
+	NetworkAddress = net.JoinHostPort(NetworkIP.String(), Config.Node.Connectivity.NetworkPort)
+	RestAddress = net.JoinHostPort(NetworkIP.String(), Config.Streaming.RestPort)
+	// the UDP port of an internal node can't be reached
+	if Config.Node.Connectivity.QuicPort != "" && !Config.Node.Connectivity.Internal {
+		QuicAddress = net.JoinHostPort(NetworkIP.String(), Config.Node.Connectivity.QuicPort)
+	}
+}
+
+// SetMappedNetworkAddress advertises the port forwarded to an internal node by the gateway of its network
+func SetMappedNetworkAddress(ip net.IP, port uint16) {
+	NetworkIP = ip
+	NetworkAddress = net.JoinHostPort(ip.String(), strconv.FormatUint(uint64(port), 10))
+	utils.Log("setting.NetworkAddress", NetworkAddress)
+}
+
+// interfaceNetworkAddress the address of the node among the addresses of its interfaces. An IPv4 address is preferred,
+// a global IPv6 one is used on IPv6-only hosts
+func interfaceNetworkAddress(addrs []net.Addr) string {
+	netAddr, ipv6Addr := "", ""
+	for _, address := range addrs {
+		if ipnet, ok := address.(*net.IPNet); ok && !ipnet.IP.IsLoopback() {
+			if ipnet.IP.To4() != nil {
+				netAddr = ipnet.IP.String()
+			} else if ipv6Addr == "" && ipnet.IP.IsGlobalUnicast() {
+				ipv6Addr = ipnet.IP.String()
+			}
+		}
+	}
+	if netAddr == "" {
+		return ipv6Addr
+	}
+	return netAddr
+}
+
+// trimIPv6Brackets IPv6 literals can be written between brackets, as in URLs
+func trimIPv6Brackets(address string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(address, "["), "]")
 }
 
 func GetP2pServerPort() string {
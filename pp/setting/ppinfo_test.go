@@ -0,0 +1,80 @@
+package setting
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+func TestTrimIPv6Brackets(t *testing.T) {
+	tests := []struct {
+		address string
+		trimmed string
+	}{
+		{"[2001:db8::1]", "2001:db8::1"},
+		{"2001:db8::1", "2001:db8::1"},
+		{"127.0.0.1", "127.0.0.1"},
+		{"node.example.com", "node.example.com"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if trimmed := trimIPv6Brackets(test.address); trimmed != test.trimmed {
+			t.Fatalf("wrong address %v for %v, expected %v", trimmed, test.address, test.trimmed)
+		}
+	}
+}
+
+func TestInterfaceNetworkAddress(t *testing.T) {
+	ipNet := func(ip string) net.Addr {
+		return &net.IPNet{IP: net.ParseIP(ip)}
+	}
+	tests := []struct {
+		name    string
+		addrs   []net.Addr
+		address string
+	}{
+		{"ipv4 preferred", []net.Addr{ipNet("2001:db8::1"), ipNet("192.168.1.2")}, "192.168.1.2"},
+		{"global ipv6", []net.Addr{ipNet("::1"), ipNet("fe80::1"), ipNet("2001:db8::1"), ipNet("2001:db8::2")}, "2001:db8::1"},
+		{"loopback only", []net.Addr{ipNet("127.0.0.1"), ipNet("::1")}, ""},
+		{"link-local ipv6 only", []net.Addr{ipNet("fe80::1")}, ""},
+		{"not an ip network", []net.Addr{&net.TCPAddr{IP: net.ParseIP("192.168.1.2")}}, ""},
+		{"none", nil, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if address := interfaceNetworkAddress(test.addrs); address != test.address {
+				t.Fatalf("wrong address %v, expected %v", address, test.address)
+			}
+		})
+	}
+}
+
+func TestSetMyNetworkAddress(t *testing.T) {
+	utils.NewDefaultLogger("", false, false)
+	Config = DefaultConfig()
+	Config.Node.Connectivity.NetworkPort = "18081"
+	Config.Streaming.RestPort = "18082"
+	tests := []struct {
+		networkAddress string
+		address        string
+		restAddress    string
+	}{
+		{"1.2.3.4", "1.2.3.4:18081", "1.2.3.4:18082"},
+		{"2001:db8::1", "[2001:db8::1]:18081", "[2001:db8::1]:18082"},
+		{"[2001:db8::1]", "[2001:db8::1]:18081", "[2001:db8::1]:18082"},
+	}
+	for _, test := range tests {
+		t.Run(test.networkAddress, func(t *testing.T) {
+			Config.Node.Connectivity.NetworkAddress = test.networkAddress
+			SetMyNetworkAddress()
+			if NetworkAddress != test.address || RestAddress != test.restAddress {
+				t.Fatalf("wrong addresses %v and %v, expected %v and %v", NetworkAddress, RestAddress, test.address, test.restAddress)
+			}
+			host, port, err := net.SplitHostPort(NetworkAddress)
+			if err != nil || net.ParseIP(host) == nil || port != "18081" {
+				t.Fatal("the network address should split back into its ip and port", host, port, err)
+			}
+		})
+	}
+}
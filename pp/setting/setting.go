@@ -11,7 +11,6 @@ import (
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
-	"github.com/stratosnet/sds/framework/client/cf"
 	"github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/tx-client/grpc"
 )
@@ -92,19 +91,60 @@ type KeysConfig struct {
 
 type ConnectivityConfig struct {
 	SeedMetaNode   SPBaseInfo `toml:"seed_meta_node" comment:"The first meta node to connect to when starting the node"`
-	Internal       bool       `toml:"internal" comment:"Is the node running on an internal network? Eg: false"`
-	NetworkAddress string     `toml:"network_address" comment:"Domain name or IP address of the node. Eg: \"127.0.0.1\""`
+	Internal       bool       `toml:"internal" comment:"Is the node running on an internal network? Other resource nodes reach it through a port mapped by the gateway, or through its relay peer. Eg: false"`
+	PortMapping    bool       `toml:"port_mapping" comment:"Should an internal node ask the gateway of its network to forward the network_port to it, with UPnP or NAT-PMP? Eg: false"`
+	RelayPeer      string     `toml:"relay_peer" comment:"(Optional)Network address of a resource node relaying the conns to this internal node, when the port can't be mapped. Eg: \"1.2.3.4:18081\""`
+	RelayCapacity  int        `toml:"relay_capacity" comment:"Number of internal nodes this node can relay. 0 means it doesn't relay other nodes. Eg: 0"`
+	NetworkAddress string     `toml:"network_address" comment:"Domain name or IP address of the node. IPv6 addresses can be written between brackets. Eg: \"127.0.0.1\" or \"[2001:db8::1]\""`
 	NetworkPort    string     `toml:"network_port" comment:"Main port for communication on the network. Must be open to the internet. Eg: \"18081\""`
 	LocalPort      string     `toml:"local_port" comment:"(Optional)If not empty, the node will listen to this port locally, but other nodes will still use the network_port to connect to this node"`
+	QuicPort       string     `toml:"quic_port" comment:"(Optional)UDP port of the QUIC transport, used for the slice traffic with the resource nodes which also enable it. Empty to only use TCP. Eg: \"18082\""`
+	Multiplexing   bool       `toml:"multiplexing" comment:"Should the conns to another resource node be streams of a single TCP connection? Nodes which don't support it get separate TCP connections. Eg: true"`
 	MetricsPort    string     `toml:"metrics_port" comment:"Port for prometheus metrics"`
 	RpcPort        string     `toml:"rpc_port" comment:"Port for the JSON-RPC api. See https://docs.thestratos.org/docs-resource-node/sds-rpc-for-file-operation/"`
 	RpcNamespaces  string     `toml:"rpc_namespaces" comment:"Namespaces enabled in the RPC API. Eg: \"user,owner\""`
+	// MinHandshakeVersion from version 2, the P2P address of the peer is proven by a signature with its P2P key. The
+	// default stays 1 while older nodes are upgraded, 2 is the target
+	MinHandshakeVersion uint8 `toml:"min_handshake_version" comment:"Lowest handshake version accepted from peers. 1 still accepts nodes which don't prove their P2P key, 2 rejects them. Set it to 2 once the peers are upgraded: with 1, a conn to a peer which doesn't advertise its version can be forced down to 1. Eg: 2"`
+	// CompressionThreshold the compression is used on the conns to the peers which enable it too, from handshake version 4
+	CompressionThreshold int `toml:"compression_threshold" comment:"Messages of at least this size (in bytes) are compressed with zstd, when the peer also enables it. Data which doesn't compress, like most slices, is sent raw. 0 disables the compression. Eg: 1024"`
+}
+
+type SliceStoreConfig struct {
+	Type        string        `toml:"type" comment:"How slices are stored. \"fs\": one file per slice in the storage path, \"pack\": slices appended to large pack files with an index, \"s3\": S3-compatible object storage. Eg: \"fs\""`
+	PackMaxSize uint64        `toml:"pack_max_size" comment:"Size of a pack file before a new one is started (in megabytes), for the pack store. Eg: 4096"`
+	S3          S3StoreConfig `toml:"s3" comment:"Configuration of the S3-compatible object storage, for the s3 store"`
+}
+
+type S3StoreConfig struct {
+	Endpoint  string `toml:"endpoint" comment:"URL of the S3-compatible service. Eg: \"http://127.0.0.1:9000\""`
+	Region    string `toml:"region" comment:"Region of the bucket. Eg: \"us-east-1\""`
+	Bucket    string `toml:"bucket" comment:"Bucket where the slices are stored. It must already exist"`
+	Prefix    string `toml:"prefix" comment:"(Optional) Prefix of the keys of the slice objects. Eg: \"slices/\""`
+	AccessKey string `toml:"access_key"`
+	SecretKey string `toml:"secret_key"`
+}
+
+type ScrubConfig struct {
+	Enabled       bool   `toml:"enabled" comment:"Should the stored slices be verified periodically? Eg: true"`
+	IntervalHours uint64 `toml:"interval_hours" comment:"Time between two verifications of all the stored slices (in hours). Eg: 168"`
+	IoBudget      uint64 `toml:"io_budget" comment:"Maximum read rate while verifying slices (in megabytes per second). Eg: 20"`
+}
+
+type ChunkingConfig struct {
+	ContentDefined bool   `toml:"content_defined" comment:"Cut uploaded files into slices at boundaries found in their content instead of fixed offsets, so the unchanged parts of a new version of a file keep the same slices and aren't sent again. The slices of encrypted files still change with each upload. Eg: false"`
+	MinSliceSize   uint64 `toml:"min_slice_size" comment:"Minimum size of a content defined slice (in megabytes). Eg: 8"`
+	AvgSliceSize   uint64 `toml:"avg_slice_size" comment:"Average size of a content defined slice (in megabytes). Eg: 16"`
+	MaxSliceSize   uint64 `toml:"max_slice_size" comment:"Maximum size of a content defined slice (in megabytes), at most 32. Eg: 32"`
 }
 
 type NodeConfig struct {
 	Debug        bool               `toml:"debug" comment:"Should debug info be printed out in logs? Eg: false"`
 	MaxDiskUsage uint64             `toml:"max_disk_usage" comment:"When not 0, limit disk usage to this amount (in megabytes) Eg: 7629394 = 8 * 1000 * 1000 * 1000 * 1000 / 1024 / 1024  (8TB) "`
 	Connectivity ConnectivityConfig `toml:"connectivity"`
+	SliceStore   SliceStoreConfig   `toml:"slice_store" comment:"Backend storing the slices of this node"`
+	Scrub        ScrubConfig        `toml:"scrub" comment:"Periodic verification of the integrity of the stored slices"`
+	Chunking     ChunkingConfig     `toml:"chunking" comment:"How uploaded files are cut into slices"`
 }
 
 type MonitorConfig struct {
@@ -115,11 +155,41 @@ type MonitorConfig struct {
 	AllowedOrigins []string `toml:"allowed_origins" comment:"List of IPs that are allowed to connect to the monitor websocket port. This is used to decide which IP can connect their monitor to the node, NOT to decide who can view the monitor UI page."`
 }
 
+type CaptureConfig struct {
+	Enabled     bool   `toml:"enabled" comment:"Should the messages exchanged with the other nodes be recorded, to debug them with \"ppd capture\"? Eg: false"`
+	Path        string `toml:"path" comment:"File receiving the captured messages. Eg: \"./tmp/capture/capture.jsonl\""`
+	MaxFileSize int    `toml:"max_file_size" comment:"The capture file is rotated when it reaches this size (in megabytes). Eg: 100"`
+	MaxFiles    int    `toml:"max_files" comment:"Number of rotated capture files kept. Eg: 5"`
+	IncludeData bool   `toml:"include_data" comment:"Should the slice data carried by the messages be captured too? Eg: false"`
+}
+
+type TracingConfig struct {
+	Enabled      bool    `toml:"enabled" comment:"Should the spans of the RPC calls, P2P messages and slice transfers be exported? Eg: false"`
+	OtlpEndpoint string  `toml:"otlp_endpoint" comment:"Address of the OTLP/HTTP collector receiving the spans. Eg: \"127.0.0.1:4318\""`
+	Insecure     bool    `toml:"insecure" comment:"Should the spans be sent to the collector over plain HTTP? Eg: true"`
+	SampleRatio  float64 `toml:"sample_ratio" comment:"Ratio of the traces started by this node which are recorded. The traces started by a peer follow its decision. Eg: 1"`
+}
+
 type TrafficConfig struct {
 	LogInterval     uint64 `toml:"log_interval" comment:"Interval at which traffic is logged (in seconds) Eg: 10"`
 	MaxConnections  int    `toml:"max_connections" comment:"Max number of concurrent network connections. Eg: 1000"`
-	MaxDownloadRate uint64 `toml:"max_download_rate" comment:"Max number of download messages received per second (per connection). 0 Means unlimited. 1000 ≈ 1MB/sec. Eg: 1000"`
-	MaxUploadRate   uint64 `toml:"max_upload_rate" comment:"Max number of upload messages sent per second (per connection). 0 Means unlimited. 1000 ≈ 1MB/sec. Eg: 1000"`
+	MaxDownloadRate uint64 `toml:"max_node_download_rate" comment:"Max rate of the slice data received by the node (in KB/sec), shared between the peers. 0 Means unlimited. 1000 ≈ 1MB/sec. Eg: 1000"`
+	MaxUploadRate   uint64 `toml:"max_node_upload_rate" comment:"Max rate of the slice data sent by the node (in KB/sec), shared between the peers. 0 Means unlimited. 1000 ≈ 1MB/sec. Eg: 1000"`
+	// LegacyMaxDownloadRate and LegacyMaxUploadRate are the per connection rates of the older configs, moved to the
+	// peer rates when the config is loaded
+	LegacyMaxDownloadRate uint64 `toml:"max_download_rate,omitempty"`
+	LegacyMaxUploadRate   uint64 `toml:"max_upload_rate,omitempty"`
+	// MaxPeerDownloadRate caps the share of a peer, even when the others don't use theirs
+	MaxPeerDownloadRate uint64              `toml:"max_peer_download_rate" comment:"Max rate of the slice data received from one peer (in KB/sec). 0 Means unlimited. Eg: 0"`
+	MaxPeerUploadRate   uint64              `toml:"max_peer_upload_rate" comment:"Max rate of the slice data sent to one peer (in KB/sec). 0 Means unlimited. Eg: 0"`
+	Schedules           []BandwidthSchedule `toml:"schedules" comment:"(Optional) Other node-wide rates during some hours of the day, eg: more bandwidth off-peak. The first matching schedule is used"`
+}
+
+type BandwidthSchedule struct {
+	Start           string `toml:"start" comment:"Local time at which the schedule starts (HH:MM). Eg: \"22:00\""`
+	End             string `toml:"end" comment:"Local time at which the schedule ends (HH:MM). It can be on the next day. Eg: \"06:00\""`
+	MaxDownloadRate uint64 `toml:"max_node_download_rate" comment:"Max rate of the slice data received by the node during the schedule (in KB/sec). 0 Means unlimited. Eg: 5000"`
+	MaxUploadRate   uint64 `toml:"max_node_upload_rate" comment:"Max rate of the slice data sent by the node during the schedule (in KB/sec). 0 Means unlimited. Eg: 5000"`
 }
 
 type StreamingConfig struct {
@@ -127,6 +197,15 @@ type StreamingConfig struct {
 	RestPort     string `toml:"rest_port" comment:"Port for the REST server"`
 }
 
+type S3GatewayConfig struct {
+	Enabled     bool   `toml:"enabled" comment:"Serve an S3-compatible API storing the objects as files of the node wallet. The clients must use path-style requests. Eg: false"`
+	Port        string `toml:"port" comment:"Port of the S3-compatible API. Eg: \"18781\""`
+	Region      string `toml:"region" comment:"Region the clients should sign their requests for. Eg: \"us-east-1\""`
+	AccessKey   string `toml:"access_key" comment:"Access key of the clients. The requests must be signed with this key pair, since the gateway acts on behalf of the node wallet"`
+	SecretKey   string `toml:"secret_key"`
+	DesiredTier uint32 `toml:"desired_tier" comment:"Tier of the PPs storing the uploaded objects. Eg: 2"`
+}
+
 type WebServerConfig struct {
 	Path           string `toml:"path" comment:"Location of the web server files Eg: \"./web\""`
 	Port           string `toml:"port" comment:"Port where the web server is hosted with sdsweb. If the port is opened and token_on_startup is true, anybody who loads the monitor UI will have full access to the monitor"`
@@ -141,7 +220,10 @@ type config struct {
 	Node       NodeConfig       `toml:"node" comment:"Configuration of this node"`
 	Monitor    MonitorConfig    `toml:"monitor" comment:"Configuration for the monitor server"`
 	Streaming  StreamingConfig  `toml:"streaming" comment:"Configuration for video streaming"`
+	S3Gateway  S3GatewayConfig  `toml:"s3_gateway" comment:"Configuration for the S3-compatible gateway"`
 	Traffic    TrafficConfig    `toml:"traffic"`
+	Tracing    TracingConfig    `toml:"tracing" comment:"Distributed tracing with OpenTelemetry"`
+	Capture    CaptureConfig    `toml:"capture" comment:"Capture of the P2P messages, for debugging"`
 	WebServer  WebServerConfig  `toml:"web_server" comment:"Configuration for the web server (when running sdsweb)"`
 }
 
@@ -177,8 +259,12 @@ func LoadConfig(configPath string) error {
 		IsWindows = false
 	}
 
-	cf.SetMaxDownloadRate(Config.Traffic.MaxDownloadRate)
-	cf.SetMaxUploadRate(Config.Traffic.MaxUploadRate)
+	if err = migrateTrafficConfig(); err != nil {
+		return err
+	}
+	if err = ApplyBandwidthLimits(); err != nil {
+		return err
+	}
 
 	// todo: we shouldn't call grpc package to setup a global variable
 	grpc.SERVER = Config.Blockchain.GrpcServer
@@ -275,12 +361,38 @@ func DefaultConfig() *config {
 					NetworkAddress: meta_net,
 				},
 				Internal:       false,
+				PortMapping:    false,
+				RelayPeer:      "",
+				RelayCapacity:  0,
 				NetworkAddress: "127.0.0.1",
 				NetworkPort:    "18081",
 				LocalPort:      "",
+				QuicPort:       "",
+				Multiplexing:   true,
 				MetricsPort:    "18181",
 				RpcPort:        "18281",
 				RpcNamespaces:  "user",
+
+				MinHandshakeVersion:  1,
+				CompressionThreshold: 1024,
+			},
+			SliceStore: SliceStoreConfig{
+				Type:        "fs",
+				PackMaxSize: 4096,
+				S3: S3StoreConfig{
+					Region: "us-east-1",
+				},
+			},
+			Scrub: ScrubConfig{
+				Enabled:       false,
+				IntervalHours: 168,
+				IoBudget:      20,
+			},
+			Chunking: ChunkingConfig{
+				ContentDefined: false,
+				MinSliceSize:   8,
+				AvgSliceSize:   16,
+				MaxSliceSize:   32,
 			},
 		},
 		Monitor: MonitorConfig{
@@ -294,11 +406,35 @@ func DefaultConfig() *config {
 			InternalPort: "18481",
 			RestPort:     "18581",
 		},
+		S3Gateway: S3GatewayConfig{
+			Enabled:     false,
+			Port:        "18781",
+			Region:      "us-east-1",
+			AccessKey:   "",
+			SecretKey:   "",
+			DesiredTier: 2,
+		},
 		Traffic: TrafficConfig{
 			LogInterval:     10,
 			MaxConnections:  DefaultMaxConnections,
 			MaxDownloadRate: 0,
 			MaxUploadRate:   0,
+
+			MaxPeerDownloadRate: 0,
+			MaxPeerUploadRate:   0,
+		},
+		Tracing: TracingConfig{
+			Enabled:      false,
+			OtlpEndpoint: "127.0.0.1:4318",
+			Insecure:     true,
+			SampleRatio:  1,
+		},
+		Capture: CaptureConfig{
+			Enabled:     false,
+			Path:        "./tmp/capture/capture.jsonl",
+			MaxFileSize: 100,
+			MaxFiles:    5,
+			IncludeData: false,
 		},
 		WebServer: WebServerConfig{
 			Path:           "./web",
@@ -342,6 +478,11 @@ func formalizePaths() (err error) {
 		return err
 	}
 
+	Config.Capture.Path, err = formalizePath(Config.Capture.Path, defaultValues.Capture.Path)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -17,6 +17,8 @@ type SPBaseInfo struct {
 	P2PAddress     string `toml:"p2p_address" json:"p2p_address"`
 	P2PPublicKey   string `toml:"p2p_public_key" json:"p2p_public_key"`
 	NetworkAddress string `toml:"network_address" json:"network_address"`
+	// HandshakeVersion the highest handshake version advertised by the SP, 0 when unknown
+	HandshakeVersion uint32 `toml:"handshake_version,omitempty" json:"handshake_version,omitempty"`
 }
 
 type SPList struct {
@@ -43,9 +45,10 @@ func UpdateSpMap(lst []*protos.SPBaseInfo) {
 	SPMap = &sync.Map{}
 	for _, spInList := range lst {
 		spInMap := SPBaseInfo{
-			P2PAddress:     spInList.P2PAddress,
-			P2PPublicKey:   spInList.P2PPubKey,
-			NetworkAddress: spInList.NetworkAddress,
+			P2PAddress:       spInList.P2PAddress,
+			P2PPublicKey:     spInList.P2PPubKey,
+			NetworkAddress:   spInList.NetworkAddress,
+			HandshakeVersion: spInList.HandshakeVersion,
 		}
 		SPMap.Store(spInList.P2PAddress, spInMap)
 	}
@@ -0,0 +1,96 @@
+// Package sigv4 implements the AWS signature version 4 used by the S3 API, shared by the S3 client of the slice store
+// and the S3 gateway
+package sigv4
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	Algorithm  = "AWS4-HMAC-SHA256"
+	DateFormat = "20060102T150405Z"
+	Service    = "s3"
+	Terminator = "aws4_request"
+)
+
+// Scope returns <date>/<region>/s3/aws4_request
+func Scope(date, region string) string {
+	return strings.Join([]string{date, region, Service, Terminator}, "/")
+}
+
+// CanonicalRequest builds the canonical request from the escaped path, and the values of the signed headers in the
+// same order as their names
+func CanonicalRequest(method, escapedPath string, query url.Values, signedHeaders, headerValues []string, payloadHash string) string {
+	var headers strings.Builder
+	for i, name := range signedHeaders {
+		headers.WriteString(name + ":" + headerValues[i] + "\n")
+	}
+	return strings.Join([]string{
+		method,
+		escapedPath,
+		CanonicalQuery(query),
+		headers.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+}
+
+// StringToSign returns the string signed for a request
+func StringToSign(amzDate, scope, canonicalRequest string) string {
+	return strings.Join([]string{Algorithm, amzDate, scope, Sha256Hex([]byte(canonicalRequest))}, "\n")
+}
+
+// SigningKey derives the key signing the requests of a day in a region
+func SigningKey(secretKey, date, region string) []byte {
+	key := HmacSha256([]byte("AWS4"+secretKey), date)
+	key = HmacSha256(key, region)
+	key = HmacSha256(key, Service)
+	return HmacSha256(key, Terminator)
+}
+
+// Sign returns the hex encoded signature of stringToSign
+func Sign(key []byte, stringToSign string) string {
+	return hex.EncodeToString(HmacSha256(key, stringToSign))
+}
+
+func CanonicalQuery(query url.Values) string {
+	var params []string
+	for key, values := range query {
+		for _, value := range values {
+			params = append(params, Escape(key, false)+"="+Escape(value, false))
+		}
+	}
+	sort.Strings(params)
+	return strings.Join(params, "&")
+}
+
+// Escape escapes everything but the unreserved characters, as required by the signature
+func Escape(s string, keepSlash bool) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (keepSlash && c == '/') {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func Sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func HmacSha256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -445,7 +445,7 @@ func addSeqNum2FileName(filePath string, seq int) string {
 
 // SubscribeDownloadResult when download is done, notification is set to subscribers
 func SubscribeDownloadResult(key string) chan bool {
-	event := make(chan bool)
+	event := make(chan bool, 1)
 	downloadResultChan.Store(key, event)
 	return event
 }
@@ -458,7 +458,10 @@ func UnsubscribeDownloadResult(key string) {
 func SetDownloadResultToRpc(fileHash string, result bool) {
 	downloadResultChan.Range(func(k, v interface{}) bool {
 		if strings.HasPrefix(k.(string), fileHash) {
-			v.(chan bool) <- result
+			select {
+			case v.(chan bool) <- result:
+			default:
+			}
 		}
 		return true
 	})
@@ -6,7 +6,6 @@ import (
 
 	"github.com/pkg/errors"
 
-	"github.com/stratosnet/sds/framework/crypto"
 	"github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/pp/file"
 	"github.com/stratosnet/sds/pp/metrics"
@@ -132,13 +131,16 @@ func SaveTransferData(target *protos.RspTransferDownload) (bool, error) {
 	if err != nil {
 		return false, errors.Wrap(err, "Failed getting slice data")
 	}
-	sliceHash, err := crypto.CalcSliceHash(sliceData, tTask.FileHash, tTask.SliceNum)
+	sliceHash, err := file.CalcSliceHashLike(tTask.SliceStorageInfo.SliceHash, sliceData, tTask.FileHash, tTask.SliceNum)
 	if err != nil {
 		return false, err
 	}
 	if tTask.SliceStorageInfo.SliceHash != sliceHash {
 		return false, errors.New("whole slice received, but slice hash doesn't match")
 	}
+	if err = file.CommitSlice(sliceHash, tTask.FileHash, tTask.SliceNum); err != nil {
+		return false, errors.Wrap(err, "failed committing slice")
+	}
 	utils.DebugLogf("whole slice received, sliceHash=%v", tTask.SliceStorageInfo.SliceHash)
 	return true, nil
 
@@ -120,6 +120,7 @@ func CreateBackupFileTask(target *protos.RspBackupStatus, fn func(ctx context.Co
 		}
 		task.destinations[slice.PpInfo.P2PAddress].slices = append(task.destinations[slice.PpInfo.P2PAddress].slices, sws)
 	}
+	task.saveRecord()
 	metrics.TaskCount.WithLabelValues("upload").Inc()
 	return task
 }
@@ -148,7 +149,15 @@ func CreateUploadFileTask(target *protos.RspUploadFile, fn func(ctx context.Cont
 	}
 	task.rspUploadFile[0] = target
 
+	storedSlices := make(map[string]bool)
+	for _, sliceHash := range target.StoredSliceHashes {
+		storedSlices[sliceHash] = true
+	}
 	for _, slice := range target.Slices {
+		// the slices already stored in the network don't need to be sent again
+		if storedSlices[slice.SliceHash] {
+			continue
+		}
 		_, ok := task.destinations[slice.PpInfo.P2PAddress]
 		if !ok {
 			task.destinations[slice.PpInfo.P2PAddress] = &SlicesPerDestination{
@@ -162,6 +171,7 @@ func CreateUploadFileTask(target *protos.RspUploadFile, fn func(ctx context.Cont
 		}
 		task.destinations[slice.PpInfo.P2PAddress].slices = append(task.destinations[slice.PpInfo.P2PAddress].slices, sws)
 	}
+	task.saveRecord()
 	metrics.TaskCount.WithLabelValues("upload").Inc()
 	return task
 }
@@ -182,16 +192,26 @@ func (u *UploadFileTask) addNewSlice(slice *protos.SliceHashAddr) {
 	})
 }
 
-func (u *UploadFileTask) SignalNewDestinations(ctx context.Context) {
-	fileHash := ""
+func (u *UploadFileTask) getFileHash() string {
 	if u.rspUploadFile != nil {
-		fileHash = u.rspUploadFile[0].FileHash
+		return u.rspUploadFile[0].FileHash
 	}
 	if u.rspBackupFile != nil {
-		fileHash = u.rspBackupFile.FileHash
+		return u.rspBackupFile.FileHash
 	}
+	return ""
+}
+
+// GetFileHash returns the hash of the file, for both new uploads and backups
+func (u *UploadFileTask) GetFileHash() string {
+	u.mutex.RLock()
+	defer u.mutex.RUnlock()
+	return u.getFileHash()
+}
+
+func (u *UploadFileTask) SignalNewDestinations(ctx context.Context) {
 	if u.helper != nil {
-		u.helper(ctx, fileHash)
+		u.helper(ctx, u.getFileHash())
 	}
 }
 
@@ -287,9 +307,10 @@ func (u *UploadFileTask) GetUploadSpP2pAddress() string {
 }
 
 func (u *UploadFileTask) SetRspUploadFile(rspUploadFile *protos.RspUploadFile) {
-	u.mutex.RLock()
-	defer u.mutex.RUnlock()
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
 	u.rspUploadFile[u.retryCount] = rspUploadFile
+	u.saveRecord()
 }
 
 // SliceFailuresToReport returns the list of slices that will require a new destination, and a boolean list of the same length indicating which slices actually failed
@@ -323,9 +344,10 @@ func (u *UploadFileTask) CanRetry() bool {
 }
 
 func (u *UploadFileTask) UpdateRetryCount() {
-	u.mutex.RLock()
-	defer u.mutex.RUnlock()
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
 	u.retryCount++
+	u.saveRecord()
 }
 
 func (u *UploadFileTask) GetExcludedDestinations() []*protos.PPBaseInfo {
@@ -400,6 +422,7 @@ func (u *UploadFileTask) UpdateSliceDestinationsForRetry(newDestinations []*prot
 			}
 		}
 	}
+	u.saveRecord()
 }
 
 func (u *UploadFileTask) Pause() {
@@ -444,6 +467,9 @@ func (u *UploadFileTask) SetUploadSliceStatus(sliceHash string, status int) erro
 					continue
 				}
 				s.Status = status
+				if status == SLICE_STATUS_FINISHED {
+					u.saveSliceStatus(sliceHash, status)
+				}
 				return nil
 			}
 		}
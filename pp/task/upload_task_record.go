@@ -0,0 +1,326 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const (
+	uploadTaskRecordExt  = ".json"
+	uploadTaskJournalExt = ".log"
+)
+
+var recordMutex sync.Mutex
+
+// uploadTaskRecord is the on-disk representation of an UploadFileTask, used to resume the upload after a restart
+type uploadTaskRecord struct {
+	FileHash      string            `json:"file_hash"`
+	UploadType    protos.UploadType `json:"upload_type"`
+	FileCRC       uint32            `json:"file_crc"`
+	RetryCount    int               `json:"retry_count"`
+	RspUploadFile map[int][]byte    `json:"rsp_upload_file,omitempty"` // protobuf encoded RspUploadFile, indexed by retry count
+	RspBackupFile []byte            `json:"rsp_backup_file,omitempty"` // protobuf encoded RspBackupStatus
+	Progress      *UploadProgress   `json:"progress,omitempty"`
+	Slices        []sliceRecord     `json:"slices"`
+}
+
+type sliceRecord struct {
+	Slice  []byte `json:"slice"` // protobuf encoded SliceHashAddr, including its destination
+	Status int    `json:"status"`
+}
+
+// sliceStatusRecord is a line of the journal of an upload task. The status changes of the slices are appended to the
+// journal instead of rewriting the whole record, and replayed over the record when it is loaded
+type sliceStatusRecord struct {
+	SliceHash string          `json:"slice_hash"`
+	Status    int             `json:"status"`
+	Progress  *UploadProgress `json:"progress,omitempty"`
+}
+
+func getUploadTaskRecordPath(fileHash string) string {
+	return filepath.Join(file.GetUploadTaskFolderPath(), fileHash+uploadTaskRecordExt)
+}
+
+func getUploadTaskJournalPath(fileHash string) string {
+	return filepath.Join(file.GetUploadTaskFolderPath(), fileHash+uploadTaskJournalExt)
+}
+
+// saveRecord writes the current state of the task to disk. The caller must hold the task mutex.
+func (u *UploadFileTask) saveRecord() {
+	fileHash := u.getFileHash()
+	if fileHash == "" {
+		return
+	}
+
+	record := &uploadTaskRecord{
+		FileHash:   fileHash,
+		UploadType: u.uploadType,
+		FileCRC:    u.fileCRC,
+		RetryCount: u.retryCount,
+	}
+	if u.rspUploadFile != nil {
+		record.RspUploadFile = make(map[int][]byte)
+		for i, rsp := range u.rspUploadFile {
+			data, err := proto.Marshal(rsp)
+			if err != nil {
+				utils.ErrorLog("failed encoding upload task record", err)
+				return
+			}
+			record.RspUploadFile[i] = data
+		}
+	}
+	if u.rspBackupFile != nil {
+		data, err := proto.Marshal(u.rspBackupFile)
+		if err != nil {
+			utils.ErrorLog("failed encoding upload task record", err)
+			return
+		}
+		record.RspBackupFile = data
+	}
+	if value, ok := UploadProgressMap.Load(fileHash); ok {
+		record.Progress = value.(*UploadProgress)
+	}
+	for _, destination := range u.destinations {
+		for _, slice := range destination.slices {
+			data, err := proto.Marshal(slice.slice)
+			if err != nil {
+				utils.ErrorLog("failed encoding upload task record", err)
+				return
+			}
+			record.Slices = append(record.Slices, sliceRecord{Slice: data, Status: slice.Status})
+		}
+	}
+
+	if err := writeUploadTaskRecord(record); err != nil {
+		utils.ErrorLogf("failed saving upload task record for file %v: %v", fileHash, err)
+	}
+}
+
+// saveSliceStatus appends the new status of a slice to the journal of the task. The caller must hold the task mutex.
+func (u *UploadFileTask) saveSliceStatus(sliceHash string, status int) {
+	fileHash := u.getFileHash()
+	if fileHash == "" {
+		return
+	}
+
+	record := &sliceStatusRecord{SliceHash: sliceHash, Status: status}
+	if value, ok := UploadProgressMap.Load(fileHash); ok {
+		record.Progress = value.(*UploadProgress)
+	}
+	if err := appendSliceStatusRecord(fileHash, record); err != nil {
+		utils.ErrorLogf("failed saving slice status for file %v: %v", fileHash, err)
+	}
+}
+
+func appendSliceStatusRecord(fileHash string, record *sliceStatusRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	recordMutex.Lock()
+	defer recordMutex.Unlock()
+	if _, err = os.Stat(getUploadTaskRecordPath(fileHash)); err != nil {
+		return errors.Wrap(err, "missing record")
+	}
+	f, err := os.OpenFile(getUploadTaskJournalPath(fileHash), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening journal")
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed writing journal")
+	}
+	return f.Close()
+}
+
+func writeUploadTaskRecord(record *uploadTaskRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	recordMutex.Lock()
+	defer recordMutex.Unlock()
+	if err = os.MkdirAll(file.GetUploadTaskFolderPath(), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed creating dir")
+	}
+	// write to a temporary file first, so a crash in the middle of the write doesn't corrupt the existing record
+	recordPath := getUploadTaskRecordPath(record.FileHash)
+	if err = os.WriteFile(recordPath+".tmp", data, 0600); err != nil {
+		return errors.Wrap(err, "failed writing record")
+	}
+	if err = os.Rename(recordPath+".tmp", recordPath); err != nil {
+		return err
+	}
+	// the record holds the status of every slice, the journal is replayed over an older record
+	err = os.Remove(getUploadTaskJournalPath(record.FileHash))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed removing journal")
+	}
+	return nil
+}
+
+// DeleteUploadTaskRecord removes the saved state of an upload task, once it doesn't need to be resumed anymore
+func DeleteUploadTaskRecord(fileHash string) {
+	recordMutex.Lock()
+	defer recordMutex.Unlock()
+	for _, path := range []string{getUploadTaskRecordPath(fileHash), getUploadTaskJournalPath(fileHash)} {
+		err := os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			utils.ErrorLogf("failed deleting upload task record for file %v: %v", fileHash, err)
+		}
+	}
+}
+
+// LoadUploadTaskRecords rebuilds the upload tasks saved on disk. The slices that were not confirmed by their
+// destination are marked as waiting for the SP, and the tasks are paused so that new destinations get requested
+func LoadUploadTaskRecords(fn func(ctx context.Context, fileHash string)) []*UploadFileTask {
+	entries, err := os.ReadDir(file.GetUploadTaskFolderPath())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			utils.ErrorLog("failed reading upload task records", err)
+		}
+		return nil
+	}
+
+	var tasks []*UploadFileTask
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), uploadTaskRecordExt) {
+			continue
+		}
+		fileHash := strings.TrimSuffix(entry.Name(), uploadTaskRecordExt)
+		uploadTask, err := loadUploadTaskRecord(fileHash, fn)
+		if err != nil {
+			utils.ErrorLogf("failed loading upload task record for file %v: %v", fileHash, err)
+			DeleteUploadTaskRecord(fileHash)
+			continue
+		}
+		tasks = append(tasks, uploadTask)
+	}
+	return tasks
+}
+
+// replayUploadTaskJournal applies the slice status changes appended after the record was written. A line torn by a
+// crash ends the journal, the slice will be uploaded again
+func replayUploadTaskJournal(fileHash string, record *uploadTaskRecord) error {
+	data, err := os.ReadFile(getUploadTaskJournalPath(fileHash))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	statuses := make(map[string]int)
+	for _, line := range strings.Split(string(data), "\n") {
+		entry := &sliceStatusRecord{}
+		if line == "" || json.Unmarshal([]byte(line), entry) != nil {
+			break
+		}
+		statuses[entry.SliceHash] = entry.Status
+		if entry.Progress != nil {
+			record.Progress = entry.Progress
+		}
+	}
+	for i, sr := range record.Slices {
+		if sr.Status == SLICE_STATUS_REPLACED {
+			continue
+		}
+		slice := &protos.SliceHashAddr{}
+		if err = proto.Unmarshal(sr.Slice, slice); err != nil {
+			return err
+		}
+		if status, ok := statuses[slice.SliceHash]; ok {
+			record.Slices[i].Status = status
+		}
+	}
+	return nil
+}
+
+func loadUploadTaskRecord(fileHash string, fn func(ctx context.Context, fileHash string)) (*UploadFileTask, error) {
+	data, err := os.ReadFile(getUploadTaskRecordPath(fileHash))
+	if err != nil {
+		return nil, err
+	}
+	record := &uploadTaskRecord{}
+	if err = json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+	if err = replayUploadTaskJournal(fileHash, record); err != nil {
+		return nil, err
+	}
+
+	uploadTask := &UploadFileTask{
+		uploadType:   record.UploadType,
+		fileCRC:      record.FileCRC,
+		retryCount:   record.RetryCount,
+		destinations: make(map[string]*SlicesPerDestination),
+		state:        STATE_PAUSED,
+		lastTouch:    time.Now(),
+		helper:       fn,
+	}
+	if len(record.RspUploadFile) > 0 {
+		uploadTask.rspUploadFile = make(map[int]*protos.RspUploadFile)
+		for i, rspData := range record.RspUploadFile {
+			rsp := &protos.RspUploadFile{}
+			if err = proto.Unmarshal(rspData, rsp); err != nil {
+				return nil, err
+			}
+			uploadTask.rspUploadFile[i] = rsp
+		}
+		if _, ok := uploadTask.rspUploadFile[0]; !ok {
+			return nil, errors.New("missing the original upload response")
+		}
+	}
+	if record.RspBackupFile != nil {
+		uploadTask.rspBackupFile = &protos.RspBackupStatus{}
+		if err = proto.Unmarshal(record.RspBackupFile, uploadTask.rspBackupFile); err != nil {
+			return nil, err
+		}
+	}
+	if uploadTask.rspUploadFile == nil && uploadTask.rspBackupFile == nil {
+		return nil, errors.New("missing the upload response")
+	}
+
+	for _, sr := range record.Slices {
+		slice := &protos.SliceHashAddr{}
+		if err = proto.Unmarshal(sr.Slice, slice); err != nil {
+			return nil, err
+		}
+		if slice.PpInfo == nil {
+			return nil, errors.New("missing slice destination")
+		}
+		status := sr.Status
+		if status != SLICE_STATUS_FINISHED && status != SLICE_STATUS_REPLACED && status != SLICE_STATUS_FAILED {
+			// the slice was never confirmed by its destination
+			status = SLICE_STATUS_WAITING_FOR_SP
+		}
+		destination, ok := uploadTask.destinations[slice.PpInfo.P2PAddress]
+		if !ok {
+			destination = &SlicesPerDestination{ppInfo: slice.PpInfo}
+			uploadTask.destinations[slice.PpInfo.P2PAddress] = destination
+		}
+		destination.slices = append(destination.slices, &SliceWithStatus{slice: slice, Status: status})
+	}
+
+	if record.Progress != nil {
+		UploadProgressMap.Store(fileHash, record.Progress)
+	}
+	if uploadTask.rspUploadFile != nil {
+		UploadTaskIdMap.Store(fileHash, uploadTask.rspUploadFile[0].TaskId)
+	}
+	return uploadTask, nil
+}
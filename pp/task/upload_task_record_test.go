@@ -0,0 +1,91 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/file"
+	"github.com/stratosnet/sds/pp/setting"
+	"github.com/stratosnet/sds/sds-msg/protos"
+)
+
+const (
+	TEST_FILE_HASH  = "v05ahm51ba4md3b0n15r4qmhsg8nc4vr8psrb4i8"
+	TEST_P2P_ADDR_1 = "TEST_P2P_ADDR_1"
+	TEST_P2P_ADDR_2 = "TEST_P2P_ADDR_2"
+)
+
+func testRspUploadFile() *protos.RspUploadFile {
+	var slices []*protos.SliceHashAddr
+	for i, p2pAddress := range []string{TEST_P2P_ADDR_1, TEST_P2P_ADDR_2, TEST_P2P_ADDR_1} {
+		slices = append(slices, &protos.SliceHashAddr{
+			SliceHash:   "slice" + string(rune('a'+i)),
+			SliceNumber: uint64(i + 1),
+			SliceSize:   10,
+			SliceOffset: &protos.SliceOffset{SliceOffsetStart: 0, SliceOffsetEnd: 10},
+			PpInfo:      &protos.PPBaseInfo{P2PAddress: p2pAddress},
+		})
+	}
+	return &protos.RspUploadFile{
+		FileHash: TEST_FILE_HASH,
+		TaskId:   "task1",
+		Slices:   slices,
+	}
+}
+
+// TestUploadTaskRecordResume checks that only the slices which were never confirmed need a new destination after reload
+func TestUploadTaskRecordResume(t *testing.T) {
+	utils.NewDefaultLogger("", false, false)
+	setting.SetupRoot(t.TempDir())
+
+	uploadTask := CreateUploadFileTask(testRspUploadFile(), nil)
+	if err := uploadTask.SetUploadSliceStatus("slicea", SLICE_STATUS_FINISHED); err != nil {
+		t.Fatal(err)
+	}
+	if exist, _ := file.PathExists(getUploadTaskJournalPath(TEST_FILE_HASH)); !exist {
+		t.Fatal("the finished slice should be appended to the journal")
+	}
+	tasks := LoadUploadTaskRecords(nil)
+	if len(tasks) != 1 {
+		t.Fatal("expected 1 upload task record, got", len(tasks))
+	}
+	if slices, _ := tasks[0].SliceFailuresToReport(); len(slices) != 2 {
+		t.Fatal("the journal should be replayed over the record, got", len(slices), "slices to re-upload")
+	}
+
+	// a new record holds the status of the slices, and replaces the journal
+	uploadTask.UpdateRetryCount()
+	if exist, _ := file.PathExists(getUploadTaskJournalPath(TEST_FILE_HASH)); exist {
+		t.Fatal("the journal should be removed when the record is rewritten")
+	}
+
+	tasks = LoadUploadTaskRecords(nil)
+	if len(tasks) != 1 {
+		t.Fatal("expected 1 upload task record, got", len(tasks))
+	}
+	resumed := tasks[0]
+	if resumed.GetFileHash() != TEST_FILE_HASH || resumed.GetUploadTaskId() != "task1" {
+		t.Fatal("wrong upload task reloaded")
+	}
+	if resumed.GetState() != STATE_PAUSED {
+		t.Fatal("resumed upload task should be paused")
+	}
+
+	slices, failed := resumed.SliceFailuresToReport()
+	if len(slices) != 2 {
+		t.Fatal("expected 2 slices to re-upload, got", len(slices))
+	}
+	for i, slice := range slices {
+		if slice.SliceHash == "slicea" {
+			t.Fatal("a finished slice should not be uploaded again")
+		}
+		if failed[i] {
+			t.Fatal("unconfirmed slices should not be reported as failed")
+		}
+	}
+
+	DeleteUploadTaskRecord(TEST_FILE_HASH)
+	if tasks = LoadUploadTaskRecords(nil); len(tasks) != 0 {
+		t.Fatal("upload task record should be deleted")
+	}
+}
@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/pp/setting"
+)
+
+const serviceName = "sds-resource-node"
+
+var (
+	tracer   = otel.Tracer("github.com/stratosnet/sds/pp")
+	provider *sdktrace.TracerProvider
+)
+
+// Initialize exports the spans of the node to the OTLP collector, when tracing is enabled. The spans of the RPC calls,
+// the P2P messages and the slice transfers all belong to the global tracer provider
+func Initialize(p2pAddress string) error {
+	config := setting.Config.Tracing
+	if !config.Enabled {
+		return nil
+	}
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(config.OtlpEndpoint)}
+	if config.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return errors.Wrap(err, "failed creating the OTLP exporter")
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceInstanceID(p2pAddress),
+	))
+	if err != nil {
+		return errors.Wrap(err, "failed creating the tracing resource")
+	}
+
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	utils.Log("exporting the spans to", config.OtlpEndpoint)
+	return nil
+}
+
+// Shutdown flushes the spans which weren't exported yet
+func Shutdown() {
+	if provider == nil {
+		return
+	}
+	if err := provider.Shutdown(context.Background()); err != nil {
+		utils.ErrorLog("failed shutting down tracing", err)
+	}
+}
+
+// StartSlice starts the span of a slice transfer with the peer
+func StartSlice(ctx context.Context, name, fileHash, sliceHash string, peerP2pAddress string) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return tracer.Start(ctx, name, trace.WithAttributes(
+		attribute.String("sds.file_hash", fileHash),
+		attribute.String("sds.slice_hash", sliceHash),
+		attribute.String("sds.peer", peerP2pAddress),
+	))
+}
+
+// End ends the span, with the error which failed the operation if any
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
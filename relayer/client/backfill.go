@@ -0,0 +1,77 @@
+package client
+
+import (
+	"github.com/stratosnet/sds/framework/utils"
+
+	"github.com/stratosnet/sds/relayer/cmd/relayd/setting"
+	"github.com/stratosnet/sds/relayer/stratoschain"
+	"github.com/stratosnet/sds/relayer/stratoschain/handlers"
+)
+
+const defaultBackfillBatchBlocks = 1000
+
+// backfill handles the events of the blocks committed since the block height cursor, which were missed while relayd
+// was down or the websocket was reconnecting. It runs after subscribing, so the blocks after the scanned range are
+// received by the subscription. The events received by both are deduplicated by the handlers
+func (s *stchainConnection) backfill() {
+	s.backfillMtx.Lock()
+	defer s.backfillMtx.Unlock()
+
+	ctx := s.ctx
+	fromHeight, err := s.cursor.load()
+	if err != nil {
+		utils.ErrorLog("Cannot backfill the missed stratos-chain events:", err.Error())
+		return
+	}
+	latestHeight, err := stratoschain.LatestHeight(ctx, s.rpc)
+	if err != nil {
+		utils.ErrorLog("Cannot backfill the missed stratos-chain events:", err.Error())
+		return
+	}
+	if fromHeight == 0 {
+		// first start, there is nothing to catch up with
+		s.cursor.backfilled(latestHeight)
+		return
+	}
+	if fromHeight >= latestHeight {
+		return
+	}
+
+	var msgTypes []string
+	for msgType := range handlers.Handlers {
+		msgTypes = append(msgTypes, msgType)
+	}
+	batchBlocks := setting.Config.StratosChain.Backfill.BatchBlocks
+	if batchBlocks <= 0 {
+		batchBlocks = defaultBackfillBatchBlocks
+	}
+
+	utils.Logf("Backfilling the stratos-chain events from height %v to %v", fromHeight+1, latestHeight)
+	succeeded := false
+	s.cursor.startBackfill()
+	defer func() { s.cursor.endBackfill(succeeded) }()
+
+	for start := fromHeight + 1; start <= latestHeight; start += batchBlocks {
+		end := start + batchBlocks - 1
+		if end > latestHeight {
+			end = latestHeight
+		}
+		if ctx.Err() != nil {
+			return // the connection was stopped
+		}
+		txs, err := stratoschain.SearchTxs(ctx, s.rpc, msgTypes, start, end)
+		if err != nil {
+			utils.ErrorLog("Failed backfilling the stratos-chain events:", err.Error())
+			return
+		}
+		for _, tx := range txs {
+			if _, err = handlers.HandleTx(tx.Hash.String(), nil, false); err != nil {
+				utils.ErrorLog("Failed backfilling the stratos-chain events:", err.Error())
+				return
+			}
+		}
+		s.cursor.backfilled(end)
+	}
+	succeeded = true
+	utils.Logf("Backfilled the stratos-chain events up to height %v", latestHeight)
+}
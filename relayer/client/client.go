@@ -2,9 +2,13 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
+
+	"github.com/pkg/errors"
 
 	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
 	fwtypes "github.com/stratosnet/sds/framework/types"
@@ -12,6 +16,14 @@ import (
 	"github.com/stratosnet/sds/tx-client/grpc"
 
 	"github.com/stratosnet/sds/relayer/cmd/relayd/setting"
+	"github.com/stratosnet/sds/relayer/leader"
+	"github.com/stratosnet/sds/relayer/outbox"
+	"github.com/stratosnet/sds/relayer/stratoschain/handlers"
+)
+
+const (
+	outboxFolder    = "data/outbox"
+	defaultLeaseTtl = 10 * time.Second
 )
 
 type MultiClient struct {
@@ -19,9 +31,12 @@ type MultiClient struct {
 	Ctx    context.Context
 	once   *sync.Once
 
-	sdsConn     connection
+	sdsConn     *sdsConnection
 	stchainConn connection
 
+	lease   leader.Lease // nil when there is a single relayd instance
+	elector *leader.Elector
+
 	WalletAddress    fwtypes.WalletAddress
 	WalletPrivateKey fwcryptotypes.PrivKey
 }
@@ -41,6 +56,14 @@ func NewClient(spHomePath string) (*MultiClient, error) {
 		once:   &sync.Once{},
 	}
 
+	if setting.Config.HA.Enabled {
+		lease, err := newLease()
+		if err != nil {
+			return nil, err
+		}
+		newClient.lease = lease
+	}
+
 	newClient.sdsConn = newSdsConnection(newClient)
 	newClient.stchainConn = newStchainConnection(newClient)
 
@@ -48,6 +71,18 @@ func NewClient(spHomePath string) (*MultiClient, error) {
 	return newClient, err
 }
 
+func newLease() (leader.Lease, error) {
+	config := setting.Config.HA
+	switch config.Backend {
+	case setting.HaBackendFile:
+		return leader.NewFileLease(config.LeaseDir)
+	case setting.HaBackendRedis:
+		return leader.NewRedisLease(config.Redis, config.KeyPrefix), nil
+	default:
+		return nil, errors.Errorf("unknown HA backend [%v]", config.Backend)
+	}
+}
+
 func (m *MultiClient) loadKeys(spHomePath string) error {
 	walletJson, err := os.ReadFile(filepath.Join(spHomePath, setting.Config.Keys.WalletPath))
 	if err != nil {
@@ -71,18 +106,67 @@ func (m *MultiClient) Start() error {
 	grpc.SERVER = setting.Config.StratosChain.GrpcServer.GrpcServer
 	grpc.INSECURE = setting.Config.StratosChain.GrpcServer.Insecure
 
-	// Start client connections
+	// Notifications to SP, delivered at least once
+	outboxConfig := setting.Config.SDS.Outbox
+	err := outbox.Start(filepath.Join(setting.HomePath, outboxFolder), outbox.Config{
+		MaxAttempts: outboxConfig.MaxAttempts,
+		MinBackoff:  time.Duration(outboxConfig.MinBackoff) * time.Millisecond,
+		MaxBackoff:  time.Duration(outboxConfig.MaxBackoff) * time.Millisecond,
+	}, handlers.DeliverToSP)
+	if err != nil {
+		return err
+	}
+
+	if m.lease == nil {
+		// Start client connections
+		go m.sdsConn.refresh()
+		go m.stchainConn.refresh()
+		return nil
+	}
+
+	// Only the leader connects, the other instances are on standby until its lease expires
+	instanceId := setting.Config.HA.InstanceId
+	if instanceId == "" {
+		hostname, _ := os.Hostname()
+		instanceId = fmt.Sprintf("%v:%v", hostname, os.Getpid())
+	}
+	leaseTtl := time.Duration(setting.Config.HA.LeaseTtl) * time.Second
+	if leaseTtl <= 0 {
+		leaseTtl = defaultLeaseTtl
+	}
+	m.sdsConn.setStandby(true)
+	m.elector = leader.NewElector(m.lease, instanceId, leaseTtl, m.onElected, m.onDemoted)
+	m.elector.Start()
+	utils.Logf("relayd instance [%v] is on standby until it holds the lease", instanceId)
+	return nil
+}
+
+// IsLeader whether the instance handles the events of stratos-chain. Always true when HA is disabled
+func (m *MultiClient) IsLeader() bool {
+	return m.elector == nil || m.elector.IsLeader()
+}
+
+func (m *MultiClient) onElected() {
+	m.stchainConn = newStchainConnection(m)
+	m.sdsConn.setStandby(false)
 	go m.sdsConn.refresh()
 	go m.stchainConn.refresh()
+}
 
-	return nil
+func (m *MultiClient) onDemoted() {
+	m.sdsConn.setStandby(true)
+	m.stchainConn.stop()
 }
 
 func (m *MultiClient) Stop() {
 	utils.DebugLogf("MultiClient.Stop ... ")
 	m.once.Do(func() {
+		if m.elector != nil {
+			m.elector.Stop()
+		}
 		m.cancel()
 		m.sdsConn.stop()
 		m.stchainConn.stop()
+		outbox.Stop()
 	})
 }
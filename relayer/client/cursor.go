@@ -0,0 +1,134 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+
+	"github.com/stratosnet/sds/relayer/cmd/relayd/setting"
+	"github.com/stratosnet/sds/relayer/utils/files"
+)
+
+const cursorFile = "data/block_height"
+
+// heightCursor the height of the last block whose events were all processed, persisted so the events emitted while
+// relayd is down can be scanned when it starts again.
+//
+// The live events are received in the order of the blocks, so the blocks below the height of a live event are fully
+// processed. While a backfill is running, the live events are ahead of it: the cursor only moves with the backfill, and
+// catches up with the live events when the backfill is done
+type heightCursor struct {
+	path        string
+	shared      sharedHeight // the cursor of the last leader, when several instances are running
+	mtx         sync.Mutex
+	saved       int64
+	live        int64 // last block fully processed by the live events
+	backfilling bool
+}
+
+// sharedHeight the block height cursor shared by the relayd instances
+type sharedHeight interface {
+	Height() (int64, error)
+	SetHeight(height int64) error
+}
+
+func newHeightCursor(shared sharedHeight) *heightCursor {
+	return &heightCursor{path: filepath.Join(setting.HomePath, cursorFile), shared: shared}
+}
+
+// load the persisted height, or 0 when relayd never processed a block
+func (c *heightCursor) load() (int64, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	var height int64
+	content, err := os.ReadFile(c.path)
+	if err != nil && !os.IsNotExist(err) {
+		return 0, errors.Wrap(err, "failed reading the block height cursor")
+	}
+	if err == nil {
+		height, err = strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid block height cursor in %v", c.path)
+		}
+	}
+	if c.shared != nil {
+		// the previous leader may have processed blocks after this instance
+		sharedHeight, err := c.shared.Height()
+		if err != nil {
+			return 0, err
+		}
+		if sharedHeight > height {
+			height = sharedHeight
+		}
+	}
+	if height > c.saved {
+		c.saved = height
+	}
+	return height, nil
+}
+
+// liveEvent records that an event of the block at height was received from the subscription
+func (c *heightCursor) liveEvent(height int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if height-1 <= c.live {
+		return
+	}
+	c.live = height - 1
+	if !c.backfilling {
+		c.save(c.live)
+	}
+}
+
+// startBackfill stops following the live events until endBackfill is called
+func (c *heightCursor) startBackfill() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.backfilling = true
+}
+
+// backfilled records that the blocks up to height were scanned by the backfill
+func (c *heightCursor) backfilled(height int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.save(height)
+}
+
+// endBackfill follows the live events again. When the backfill succeeded, it reached the blocks of the live events
+func (c *heightCursor) endBackfill(succeeded bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.backfilling = false
+	if succeeded {
+		c.save(c.live)
+	}
+}
+
+// save must be called with the mutex locked. The cursor never moves backwards
+func (c *heightCursor) save(height int64) {
+	if height <= c.saved {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		utils.ErrorLog("Failed creating the folder of the block height cursor:", err.Error())
+		return
+	}
+	if err := files.WriteAtomic(c.path, []byte(strconv.FormatInt(height, 10))); err != nil {
+		utils.ErrorLog("Failed writing the block height cursor:", err.Error())
+		return
+	}
+	c.saved = height
+	if c.shared != nil {
+		if err := c.shared.SetHeight(height); err != nil {
+			utils.ErrorLog("Failed sharing the block height cursor:", err.Error())
+		}
+	}
+}
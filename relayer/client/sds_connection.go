@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -13,22 +14,19 @@ import (
 
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
-	sdkmath "cosmossdk.io/math"
 
 	"github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/sds-msg/protos"
-	"github.com/stratosnet/sds/tx-client/grpc"
-	"github.com/stratosnet/sds/tx-client/tx"
 	txclienttypes "github.com/stratosnet/sds/tx-client/types"
 
 	"github.com/stratosnet/sds/relayer/cmd/relayd/setting"
 	"github.com/stratosnet/sds/relayer/sds"
-	"github.com/stratosnet/sds/relayer/stratoschain"
 	"github.com/stratosnet/sds/relayer/stratoschain/types"
 )
 
 const (
 	txBroadcastMaxInterval = 500 // milliseconds
+	txConfirmationInterval = 5   // seconds
 )
 
 type sdsConnection struct {
@@ -36,6 +34,8 @@ type sdsConnection struct {
 
 	sdsWebsocketConn  *websocket.Conn
 	txBroadcasterChan chan txclienttypes.UnsignedMsg
+	txBroadcaster     *txBroadcaster
+	standby           atomic.Bool // the connection isn't refreshed while relayd is on standby
 
 	cancel context.CancelFunc
 	ctx    context.Context
@@ -46,7 +46,8 @@ type sdsConnection struct {
 
 func newSdsConnection(client *MultiClient) *sdsConnection {
 	return &sdsConnection{
-		client: client,
+		client:        client,
+		txBroadcaster: newTxBroadcaster(),
 	}
 }
 
@@ -73,11 +74,22 @@ func (s *sdsConnection) stop() {
 	})
 }
 
+// setStandby stops the connection until it's refreshed again with standby false
+func (s *sdsConnection) setStandby(standby bool) {
+	s.standby.Store(standby)
+	if standby {
+		s.stop()
+	}
+}
+
 func (s *sdsConnection) refresh() {
 	if !s.mux.TryLock() {
 		return // Refresh procedure already started
 	}
 	defer s.mux.Unlock()
+	if s.standby.Load() {
+		return
+	}
 
 	s.stop() // Stop the connection if it was started before
 
@@ -215,59 +227,12 @@ func (s *sdsConnection) txBroadcasterLoop() {
 	var unsignedMsgs []*txclienttypes.UnsignedMsg
 	broadcastTxs := func() {
 		utils.Logf("Tx broadcaster loop will try to broadcast %v msgs %v", len(unsignedMsgs), countMsgsByType(unsignedMsgs))
-
-		var unsignedSdkMsgs []*anypb.Any
-		txConfig, unsignedTx := tx.CreateTxConfigAndTxBuilder()
-		for _, unsignedMsg := range unsignedMsgs {
-			unsignedSdkMsgs = append(unsignedSdkMsgs, unsignedMsg.Msg)
-		}
-		defer func() {
-			unsignedMsgs = nil // Clearing msg list
-		}()
-
-		setMsgInfoToTxBuilder(unsignedTx, unsignedSdkMsgs)
-		txBytes, err := tx.BuildTxBytes(txConfig, unsignedTx, setting.Config.BlockchainInfo.ChainId, unsignedMsgs)
-		if err != nil {
-			utils.ErrorLog("couldn't build tx bytes", err)
-			return
-		}
-
-		gasInfo, err := grpc.Simulate(txBytes)
-		if err != nil {
-			utils.ErrorLog("couldn't simulate tx bytes", err)
-			return
-		}
-		gasLimit := uint64(float64(gasInfo.GasUsed) * setting.Config.BlockchainInfo.Transactions.GasAdjustment)
-		unsignedTx.AuthInfo.Fee.GasLimit = gasLimit
-
-		gasPrice, err := txclienttypes.ParseCoinNormalized(setting.Config.BlockchainInfo.Transactions.GasPrice)
-		if err != nil {
-			utils.ErrorLog("couldn't parse gas price", err)
-			return
-		}
-		feeAmount := gasPrice.Amount.Mul(sdkmath.NewIntFromUint64(gasLimit))
-		fee := txclienttypes.NewCoin(gasPrice.Denom, feeAmount)
-		unsignedTx.AuthInfo.Fee.Amount = []*basev1beta1.Coin{
-			{
-				Denom:  fee.Denom,
-				Amount: fee.Amount.String(),
-			},
-		}
-
-		txBytes, err = tx.BuildTxBytes(txConfig, unsignedTx, setting.Config.BlockchainInfo.ChainId, unsignedMsgs)
-		if err != nil {
-			utils.ErrorLog("couldn't build tx bytes", err)
-			return
-		}
-
-		err = stratoschain.BroadcastTx(txBytes)
-		if err != nil {
-			utils.ErrorLog("couldn't broadcast transaction", err)
-			return
-		}
-
+		s.txBroadcaster.broadcast(unsignedMsgs)
+		unsignedMsgs = nil
 	}
 
+	confirmationTicker := time.NewTicker(txConfirmationInterval * time.Second)
+	defer confirmationTicker.Stop()
 	timeOver := time.After(txBroadcastMaxInterval * time.Millisecond)
 	for {
 		select {
@@ -299,6 +264,9 @@ func (s *sdsConnection) txBroadcasterLoop() {
 				broadcastTxs()
 			}
 			timeOver = time.After(txBroadcastMaxInterval * time.Millisecond)
+		case <-confirmationTicker.C:
+			// The msgs which couldn't be broadcast or committed yet are broadcast again with the next msgs
+			unsignedMsgs = append(s.txBroadcaster.checkConfirmations(), unsignedMsgs...)
 		}
 	}
 }
@@ -11,6 +11,7 @@ import (
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	tmlog "github.com/cometbft/cometbft/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	wsclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	comettypes "github.com/cometbft/cometbft/types"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/relayer/stratoschain"
 	"github.com/stratosnet/sds/relayer/stratoschain/handlers"
 )
 
@@ -30,8 +32,13 @@ const (
 type stchainConnection struct {
 	service.BaseService
 	client                *MultiClient
+	ctx                   context.Context
+	cancel                context.CancelFunc
 	stratosEventsChannels *sync.Map
 	ws                    *wsclient.WSClient
+	rpc                   *rpchttp.HTTP // queries the blocks missed by ws
+	cursor                *heightCursor
+	backfillMtx           sync.Mutex
 }
 
 func newStchainConnection(client *MultiClient) *stchainConnection {
@@ -44,11 +51,20 @@ func newStchainConnection(client *MultiClient) *stchainConnection {
 	if err != nil {
 		return nil
 	}
+	rpcClient, err := stratoschain.NewRpcClient(setting.Config.StratosChain.WebsocketServer)
+	if err != nil {
+		return nil
+	}
 
+	ctx, cancel := context.WithCancel(client.Ctx)
 	s := &stchainConnection{
 		client:                client,
+		ctx:                   ctx,
+		cancel:                cancel,
 		stratosEventsChannels: &sync.Map{},
 		ws:                    wsClient,
+		rpc:                   rpcClient,
+		cursor:                newHeightCursor(client.lease),
 	}
 
 	if ENABLE_WSCLIENT_LOG {
@@ -87,6 +103,7 @@ func (s *stchainConnection) onReconnect() {
 	if err != nil {
 		utils.ErrorLog("Failed subscribing queries:", err.Error())
 	}
+	go s.backfill()
 }
 
 func (s *stchainConnection) start() error {
@@ -96,11 +113,13 @@ func (s *stchainConnection) start() error {
 	}
 	utils.Log("Successfully subscribed to events from stratos-chain")
 	go s.readerLoop()
+	go s.backfill()
 	return nil
 }
 
 func (s *stchainConnection) stop() {
 	utils.DebugLog("stchainConnection.Stop ... ")
+	s.cancel()
 	s.ws.Stop()
 }
 
@@ -138,6 +157,9 @@ func (s *stchainConnection) readerLoop() {
 			}
 			msgType = strings.TrimRight(msgType, "'")
 
+			if eventDataTx, ok := result.Data.(comettypes.EventDataTx); ok {
+				s.cursor.liveEvent(eventDataTx.Height)
+			}
 			handler, ok := handlers.Handlers[msgType]
 			if ok && handler != nil {
 				cleanEventStrings(*result)
@@ -0,0 +1,449 @@
+package client
+
+import (
+	ed25519crypto "crypto/ed25519"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/pkg/errors"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	abciv1beta1 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	sdked25519 "cosmossdk.io/api/cosmos/crypto/ed25519"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	sdkmath "cosmossdk.io/math"
+	sdksecp256k1 "github.com/stratosnet/stratos-chain/api/stratos/crypto/v1/ethsecp256k1"
+
+	"github.com/stratosnet/sds/framework/crypto/ed25519"
+	"github.com/stratosnet/sds/framework/crypto/secp256k1"
+	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/tx-client/grpc"
+	"github.com/stratosnet/sds/tx-client/tx"
+	txclienttypes "github.com/stratosnet/sds/tx-client/types"
+	authsigning "github.com/stratosnet/sds/tx-client/types/auth/signing"
+	"github.com/stratosnet/sds/tx-client/types/tx/signing"
+
+	"github.com/stratosnet/sds/relayer/cmd/relayd/setting"
+	"github.com/stratosnet/sds/relayer/stratoschain"
+)
+
+const (
+	defaultConfirmationTimeout = 60 // seconds
+	defaultMaxRequeues         = 3
+
+	codespaceSdk        = "sdk"
+	codeWrongSequence   = 32
+	wrongSequenceErrMsg = "account sequence mismatch"
+)
+
+// accountSequence the account number and the sequence of the next tx signed by an account. The sequence is tracked
+// locally, so a tx can be signed before the previous ones are committed
+type accountSequence struct {
+	accountNum uint64
+	sequence   uint64
+}
+
+// inFlightTx a tx accepted in the mempool, waiting to be committed
+type inFlightTx struct {
+	msgs   []*txclienttypes.UnsignedMsg
+	sentAt time.Time
+}
+
+// txBroadcaster broadcasts the msgs from SP in batches. A batch rejected by the chain is split in halves until the
+// rejected msgs are isolated, so they don't take the valid ones down with them. The msgs of a tx which is never
+// committed are broadcast again, and the ones of a tx which failed in a block are split like a rejected batch. Only
+// used by the tx broadcaster loop, so it doesn't need to be thread-safe
+type txBroadcaster struct {
+	sequences     map[string]*accountSequence // by wallet address
+	inFlight      map[string]*inFlightTx      // by tx hash
+	requeues      map[*txclienttypes.UnsignedMsg]int
+	retry         []*txclienttypes.UnsignedMsg        // msgs which couldn't reach the chain
+	failedInBlock map[*txclienttypes.UnsignedMsg]bool // msgs of a tx which failed in a block, split by the next broadcast
+
+	// Reach stratos-chain, replaced in the tests
+	sendTx  func(msgs []*txclienttypes.UnsignedMsg) error
+	queryTx func(txHash string) (*abciv1beta1.TxResponse, error)
+}
+
+func newTxBroadcaster() *txBroadcaster {
+	b := &txBroadcaster{
+		sequences:     make(map[string]*accountSequence),
+		inFlight:      make(map[string]*inFlightTx),
+		requeues:      make(map[*txclienttypes.UnsignedMsg]int),
+		failedInBlock: make(map[*txclienttypes.UnsignedMsg]bool),
+		queryTx:       stratoschain.QueryTx,
+	}
+	b.sendTx = b.signAndSendTx
+	return b
+}
+
+// txError an error while broadcasting a tx. When the tx was rejected by the chain, one of its msgs is invalid.
+// Otherwise the chain couldn't be reached, and the tx can be broadcast again later
+type txError struct {
+	err      error
+	rejected bool
+}
+
+func (e *txError) Error() string {
+	return e.err.Error()
+}
+
+// broadcast the msgs, in as few txs as possible. The msgs of a tx which failed in a block are sent in halves, to
+// isolate the failing ones. The msgs which couldn't reach the chain are returned by the next checkConfirmations
+func (b *txBroadcaster) broadcast(msgs []*txclienttypes.UnsignedMsg) {
+	var validMsgs, failedMsgs []*txclienttypes.UnsignedMsg
+	for _, msg := range msgs {
+		failed := b.failedInBlock[msg]
+		delete(b.failedInBlock, msg)
+		switch {
+		case !hasValidSignatureKeys(msg):
+			utils.ErrorLogf("Dropping a msg of type [%v] without the keys to sign it", msg.Type)
+			delete(b.requeues, msg)
+		case failed:
+			failedMsgs = append(failedMsgs, msg)
+		default:
+			validMsgs = append(validMsgs, msg)
+		}
+	}
+
+	b.sendBatches(failedMsgs, true)
+	b.sendBatches(validMsgs, false)
+}
+
+// sendBatches sends the msgs in txs of at most MaxMsgPerTx msgs, each batch split in halves first if asked
+func (b *txBroadcaster) sendBatches(msgs []*txclienttypes.UnsignedMsg, split bool) {
+	maxMsgPerTx := setting.Config.StratosChain.Broadcast.MaxMsgPerTx
+	for len(msgs) > 0 {
+		batchSize := len(msgs)
+		if maxMsgPerTx > 0 && batchSize > maxMsgPerTx {
+			batchSize = maxMsgPerTx
+		}
+		batch := msgs[:batchSize]
+		msgs = msgs[batchSize:]
+		if split && len(batch) > 1 {
+			half := len(batch) / 2
+			b.bisect(batch[:half])
+			b.bisect(batch[half:])
+			continue
+		}
+		b.bisect(batch)
+	}
+}
+
+func (b *txBroadcaster) bisect(msgs []*txclienttypes.UnsignedMsg) {
+	err := b.sendTx(msgs)
+	if err != nil && isWrongSequence(err) {
+		// Another tx of the accounts was committed or dropped meanwhile
+		utils.DebugLog("Account sequences are out of sync with stratos-chain, querying them again")
+		b.resetSequences(msgs)
+		err = b.sendTx(msgs)
+		if err != nil && isWrongSequence(err) {
+			// The sequences are still changing, the msgs aren't invalid
+			utils.ErrorLogf("Account sequences of %v msgs are still out of sync, they will be broadcast again later: %v",
+				len(msgs), err.Error())
+			b.retryLater(msgs)
+			return
+		}
+	}
+	if err == nil {
+		return
+	}
+
+	if txErr, ok := err.(*txError); !ok || !txErr.rejected {
+		utils.ErrorLogf("Couldn't broadcast %v msgs, they will be broadcast again later: %v", len(msgs), err.Error())
+		b.retryLater(msgs)
+		return
+	}
+	if len(msgs) == 1 {
+		utils.ErrorLogf("Dropping a msg of type [%v] rejected by stratos-chain: %v", msgs[0].Type, err.Error())
+		delete(b.requeues, msgs[0])
+		return
+	}
+	utils.DebugLogf("A tx of %v msgs was rejected by stratos-chain, splitting it to isolate the invalid msgs: %v",
+		len(msgs), err.Error())
+	half := len(msgs) / 2
+	b.bisect(msgs[:half])
+	b.bisect(msgs[half:])
+}
+
+// retryLater keeps the msgs until the chain can be reached again. The oldest msgs are dropped when too many are kept
+func (b *txBroadcaster) retryLater(msgs []*txclienttypes.UnsignedMsg) {
+	b.retry = append(b.retry, msgs...)
+	if maxRetry := setting.Config.StratosChain.Broadcast.ChannelSize; maxRetry > 0 && len(b.retry) > maxRetry {
+		dropped := b.retry[:len(b.retry)-maxRetry]
+		utils.ErrorLogf("Dropping %v msgs %v which couldn't reach stratos-chain", len(dropped), countMsgsByType(dropped))
+		for _, msg := range dropped {
+			delete(b.requeues, msg)
+		}
+		b.retry = b.retry[len(b.retry)-maxRetry:]
+	}
+}
+
+// requeue the msgs of a tx which wasn't committed, unless they were already requeued too many times
+func (b *txBroadcaster) requeue(msgs []*txclienttypes.UnsignedMsg) []*txclienttypes.UnsignedMsg {
+	maxRequeues := setting.Config.StratosChain.Broadcast.MaxRequeues
+	if maxRequeues <= 0 {
+		maxRequeues = defaultMaxRequeues
+	}
+
+	var requeued []*txclienttypes.UnsignedMsg
+	for _, msg := range msgs {
+		if b.requeues[msg] >= maxRequeues {
+			utils.ErrorLogf("Dropping a msg of type [%v] after %v attempts to broadcast it", msg.Type, maxRequeues+1)
+			delete(b.requeues, msg)
+			continue
+		}
+		b.requeues[msg]++
+		requeued = append(requeued, msg)
+	}
+	return requeued
+}
+
+// signAndSendTx signs a tx with the msgs, simulates it to know its gas and broadcasts it
+func (b *txBroadcaster) signAndSendTx(msgs []*txclienttypes.UnsignedMsg) error {
+	signatureKeys, err := b.signatureKeys(msgs)
+	if err != nil {
+		return err
+	}
+
+	var unsignedSdkMsgs []*anypb.Any
+	txConfig, unsignedTx := tx.CreateTxConfigAndTxBuilder()
+	for _, msg := range msgs {
+		unsignedSdkMsgs = append(unsignedSdkMsgs, msg.Msg)
+	}
+	setMsgInfoToTxBuilder(unsignedTx, unsignedSdkMsgs)
+
+	txBytes, err := signTx(txConfig, unsignedTx, signatureKeys)
+	if err != nil {
+		return err
+	}
+	gasInfo, err := grpc.Simulate(txBytes)
+	if err != nil {
+		return &txError{err: errors.Wrap(err, "couldn't simulate tx"), rejected: isRejectedByChain(err)}
+	}
+	gasLimit := uint64(float64(gasInfo.GasUsed) * setting.Config.BlockchainInfo.Transactions.GasAdjustment)
+	unsignedTx.AuthInfo.Fee.GasLimit = gasLimit
+
+	gasPrice, err := txclienttypes.ParseCoinNormalized(setting.Config.BlockchainInfo.Transactions.GasPrice)
+	if err != nil {
+		return errors.Wrap(err, "couldn't parse gas price")
+	}
+	feeAmount := gasPrice.Amount.Mul(sdkmath.NewIntFromUint64(gasLimit))
+	unsignedTx.AuthInfo.Fee.Amount = []*basev1beta1.Coin{
+		{
+			Denom:  gasPrice.Denom,
+			Amount: feeAmount.String(),
+		},
+	}
+
+	txBytes, err = signTx(txConfig, unsignedTx, signatureKeys)
+	if err != nil {
+		return err
+	}
+	utils.Logf("Broadcasting a tx of %v msgs %v", len(msgs), countMsgsByType(msgs))
+	txResponse, err := stratoschain.BroadcastTx(txBytes)
+	if err != nil {
+		return &txError{err: errors.Wrap(err, "couldn't broadcast tx")}
+	}
+	if txResponse.Code != 0 {
+		return &txError{
+			err:      errors.Errorf("tx rejected with code %v/%v: %v", txResponse.Codespace, txResponse.Code, txResponse.RawLog),
+			rejected: true,
+		}
+	}
+
+	// The tx is in the mempool, the next txs of the accounts follow it
+	for _, signatureKey := range signatureKeys {
+		b.sequences[signatureKey.Address].sequence++
+	}
+	b.inFlight[txResponse.Txhash] = &inFlightTx{msgs: msgs, sentAt: time.Now()}
+	return nil
+}
+
+// signatureKeys the keys signing a tx with the msgs, in the order expected by the chain, with the account numbers and
+// the sequences tracked locally
+func (b *txBroadcaster) signatureKeys(msgs []*txclienttypes.UnsignedMsg) ([]*txclienttypes.SignatureKey, error) {
+	var signatureKeys []*txclienttypes.SignatureKey
+	signersSeen := make(map[string]bool)
+	for _, msg := range msgs {
+		for _, signatureKey := range msg.SignatureKeys {
+			if signersSeen[signatureKey.Address] {
+				continue
+			}
+			signersSeen[signatureKey.Address] = true
+
+			account, ok := b.sequences[signatureKey.Address]
+			if !ok {
+				baseAccount, err := grpc.QueryAccount(signatureKey.Address)
+				if err != nil {
+					return nil, &txError{err: errors.Wrapf(err, "couldn't fetch the account info of wallet %v", signatureKey.Address)}
+				}
+				account = &accountSequence{accountNum: baseAccount.GetAccountNumber(), sequence: baseAccount.GetSequence()}
+				b.sequences[signatureKey.Address] = account
+			}
+			signatureKey.AccountNum = account.accountNum
+			signatureKey.AccountSequence = account.sequence
+			signatureKeys = append(signatureKeys, signatureKey)
+		}
+	}
+	return signatureKeys, nil
+}
+
+// resetSequences forgets the sequences of the accounts signing the msgs, they are queried from the chain again
+func (b *txBroadcaster) resetSequences(msgs []*txclienttypes.UnsignedMsg) {
+	for _, msg := range msgs {
+		for _, signatureKey := range msg.SignatureKeys {
+			delete(b.sequences, signatureKey.Address)
+		}
+	}
+}
+
+// checkConfirmations forgets the txs committed in a block. Returns the msgs to broadcast again: the ones of the txs
+// which failed in a block or weren't committed in time, and the ones which couldn't reach the chain
+func (b *txBroadcaster) checkConfirmations() []*txclienttypes.UnsignedMsg {
+	timeout := time.Duration(setting.Config.StratosChain.Broadcast.ConfirmationTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultConfirmationTimeout * time.Second
+	}
+
+	requeued := b.retry
+	b.retry = nil
+	for txHash, inFlight := range b.inFlight {
+		txResponse, err := b.queryTx(txHash)
+		if err != nil && grpcstatus.Code(errors.Cause(err)) != grpccodes.NotFound {
+			// The chain can't tell whether the tx was committed, check it again later
+			utils.DebugLogf("Couldn't query tx [%v]: %v", txHash, err.Error())
+			continue
+		}
+		if err == nil {
+			if txResponse == nil || txResponse.Height <= 0 {
+				continue
+			}
+			delete(b.inFlight, txHash)
+			if txResponse.Code == 0 {
+				for _, msg := range inFlight.msgs {
+					delete(b.requeues, msg)
+				}
+				continue
+			}
+
+			// One of the msgs failed when executed, the next broadcast splits them like a rejected batch. A msg failing
+			// alone is broadcast again until it reaches MaxRequeues
+			utils.ErrorLogf("Tx [%v] of %v msgs %v failed in block %v with code %v/%v, broadcasting its msgs again: %v",
+				txHash, len(inFlight.msgs), countMsgsByType(inFlight.msgs), txResponse.Height, txResponse.Codespace,
+				txResponse.Code, txResponse.RawLog)
+			failedMsgs := inFlight.msgs
+			if len(failedMsgs) == 1 {
+				failedMsgs = b.requeue(failedMsgs)
+			}
+			for _, msg := range failedMsgs {
+				b.failedInBlock[msg] = true
+			}
+			requeued = append(requeued, failedMsgs...)
+			continue
+		}
+		if time.Since(inFlight.sentAt) < timeout {
+			continue
+		}
+
+		// The tx isn't in any block and was dropped from the mempool, the sequences of the next txs are wrong too
+		utils.ErrorLogf("Tx [%v] wasn't committed after %v, broadcasting its %v msgs again", txHash, timeout,
+			len(inFlight.msgs))
+		delete(b.inFlight, txHash)
+		b.resetSequences(inFlight.msgs)
+		requeued = append(requeued, b.requeue(inFlight.msgs)...)
+	}
+	return requeued
+}
+
+func hasValidSignatureKeys(msg *txclienttypes.UnsignedMsg) bool {
+	for _, signatureKey := range msg.SignatureKeys {
+		if len(signatureKey.Address) == 0 || len(signatureKey.PrivateKey) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func isWrongSequence(err error) bool {
+	return strings.Contains(err.Error(), wrongSequenceErrMsg) ||
+		strings.Contains(err.Error(), fmt.Sprintf("code %v/%v", codespaceSdk, codeWrongSequence))
+}
+
+// isRejectedByChain whether the chain answered with an error, rather than being unreachable
+func isRejectedByChain(err error) bool {
+	switch grpcstatus.Code(errors.Cause(err)) {
+	case grpccodes.Unavailable, grpccodes.DeadlineExceeded, grpccodes.Canceled:
+		return false
+	}
+	return true
+}
+
+// signTx signs the tx with the signature keys, like tx.BuildTxBytes but with the account sequences given
+func signTx(txConfig tx.TxConfig, unsignedTx *txv1beta1.Tx, signatureKeys []*txclienttypes.SignatureKey) ([]byte, error) {
+	signMode := txConfig.SignModeHandler().DefaultMode()
+	privKeys := make([]fwcryptotypes.PrivKey, len(signatureKeys))
+
+	// First round: we gather all the signer infos. We use the "set empty signature" hack to do that
+	var sigsV2 []signing.SignatureV2
+	for i, signatureKey := range signatureKeys {
+		switch signatureKey.Type {
+		case txclienttypes.SignatureEd25519:
+			if len(signatureKey.PrivateKey) != ed25519crypto.PrivateKeySize {
+				return nil, errors.New("ed25519 private key has wrong length")
+			}
+			privKeys[i] = &ed25519.PrivKey{Key: signatureKey.PrivateKey}
+		default:
+			privKeys[i] = secp256k1.Generate(signatureKey.PrivateKey)
+		}
+		pubKeyAny, err := packPubKey(privKeys[i])
+		if err != nil {
+			return nil, err
+		}
+		sigsV2 = append(sigsV2, signing.SignatureV2{
+			PubKey:   pubKeyAny,
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: signatureKey.AccountSequence,
+		})
+	}
+	signedTx, err := tx.SetSignatures(unsignedTx, sigsV2...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Second round: all signer infos are set, so each signer can sign
+	for i, signatureKey := range signatureKeys {
+		signerData := authsigning.SignerData{
+			ChainID:       setting.Config.BlockchainInfo.ChainId,
+			AccountNumber: signatureKey.AccountNum,
+			Sequence:      signatureKey.AccountSequence,
+		}
+		sigsV2[i], err = tx.SignWithPrivKey(signMode, signerData, unsignedTx, privKeys[i], txConfig, signerData.Sequence)
+		if err != nil {
+			return nil, err
+		}
+	}
+	signedTx, err = tx.SetSignatures(unsignedTx, sigsV2...)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(signedTx)
+}
+
+func packPubKey(privKey fwcryptotypes.PrivKey) (*anypb.Any, error) {
+	switch privKey.Type() {
+	case secp256k1.KeyType:
+		return anyutil.New(&sdksecp256k1.PubKey{Key: privKey.PubKey().Bytes()})
+	case ed25519.KeyType:
+		return anyutil.New(&sdked25519.PubKey{Key: privKey.PubKey().Bytes()})
+	default:
+		return nil, errors.Errorf("key type %v is not supported", privKey.Type())
+	}
+}
@@ -0,0 +1,223 @@
+package client
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	abciv1beta1 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
+
+	"github.com/stratosnet/sds/framework/utils"
+	txclienttypes "github.com/stratosnet/sds/tx-client/types"
+
+	"github.com/stratosnet/sds/relayer/cmd/relayd/setting"
+)
+
+const testWallet = "st1testwallet"
+
+// stubChain stands for stratos-chain: a tx with a rejected msg is refused by the mempool, a tx with a failing msg is
+// committed with an error code
+type stubChain struct {
+	b        *txBroadcaster
+	rejected map[*txclienttypes.UnsignedMsg]bool
+	failing  map[*txclienttypes.UnsignedMsg]bool
+	sent     [][]*txclienttypes.UnsignedMsg
+}
+
+func newStubChain(t *testing.T) *stubChain {
+	utils.NewDefaultLogger("", false, false)
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	if err := setting.GenDefaultConfig(configPath); err != nil {
+		t.Fatal("couldn't generate the config: " + err.Error())
+	}
+	if err := setting.LoadConfig(configPath); err != nil {
+		t.Fatal("couldn't load the config: " + err.Error())
+	}
+
+	chain := &stubChain{
+		b:        newTxBroadcaster(),
+		rejected: make(map[*txclienttypes.UnsignedMsg]bool),
+		failing:  make(map[*txclienttypes.UnsignedMsg]bool),
+	}
+	chain.b.sendTx = chain.sendTx
+	chain.b.queryTx = chain.queryTx
+	return chain
+}
+
+func (c *stubChain) sendTx(msgs []*txclienttypes.UnsignedMsg) error {
+	c.sent = append(c.sent, append([]*txclienttypes.UnsignedMsg(nil), msgs...))
+	for _, msg := range msgs {
+		if c.rejected[msg] {
+			return &txError{err: errors.New("tx rejected with code sdk/4: invalid msg"), rejected: true}
+		}
+	}
+	txHash := fmt.Sprintf("tx-%v", len(c.sent))
+	c.b.inFlight[txHash] = &inFlightTx{msgs: msgs, sentAt: time.Now()}
+	return nil
+}
+
+func (c *stubChain) queryTx(txHash string) (*abciv1beta1.TxResponse, error) {
+	inFlight, ok := c.b.inFlight[txHash]
+	if !ok {
+		return nil, grpcstatus.Error(grpccodes.NotFound, "tx not found")
+	}
+	txResponse := &abciv1beta1.TxResponse{Txhash: txHash, Height: 1}
+	for _, msg := range inFlight.msgs {
+		if c.failing[msg] {
+			txResponse.Code = 5
+		}
+	}
+	return txResponse, nil
+}
+
+// sentCount how many txs carried the msg
+func (c *stubChain) sentCount(msg *txclienttypes.UnsignedMsg) int {
+	count := 0
+	for _, msgs := range c.sent {
+		for _, sentMsg := range msgs {
+			if sentMsg == msg {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func newTestMsgs(count int) []*txclienttypes.UnsignedMsg {
+	var msgs []*txclienttypes.UnsignedMsg
+	for i := 0; i < count; i++ {
+		msgs = append(msgs, &txclienttypes.UnsignedMsg{
+			Type:          fmt.Sprintf("msg-%v", i),
+			SignatureKeys: []*txclienttypes.SignatureKey{{Address: testWallet, PrivateKey: []byte{1}}},
+		})
+	}
+	return msgs
+}
+
+func TestTxBroadcasterBisect(t *testing.T) {
+	chain := newStubChain(t)
+	msgs := newTestMsgs(8)
+	chain.rejected[msgs[5]] = true
+
+	chain.b.broadcast(msgs)
+
+	// 8 rejected, 4 sent, 4 rejected, 2 sent, 2 rejected, 1 sent, 1 rejected
+	if len(chain.sent) != 7 {
+		t.Fatalf("expected 7 txs to isolate the rejected msg, got %v", len(chain.sent))
+	}
+	inFlightMsgs := 0
+	for _, inFlight := range chain.b.inFlight {
+		for _, msg := range inFlight.msgs {
+			if msg == msgs[5] {
+				t.Fatal("the rejected msg shouldn't be in flight")
+			}
+			inFlightMsgs++
+		}
+	}
+	if inFlightMsgs != 7 {
+		t.Fatalf("expected the 7 valid msgs in flight, got %v", inFlightMsgs)
+	}
+	if len(chain.b.retry) != 0 {
+		t.Fatal("a rejected msg shouldn't be broadcast again")
+	}
+}
+
+func TestTxBroadcasterResetSequences(t *testing.T) {
+	chain := newStubChain(t)
+	msgs := newTestMsgs(2)
+
+	wrongSequences := 1
+	chain.b.sequences[testWallet] = &accountSequence{accountNum: 1, sequence: 10}
+	chain.b.sendTx = func(msgs []*txclienttypes.UnsignedMsg) error {
+		if wrongSequences > 0 {
+			wrongSequences--
+			return &txError{err: errors.New(wrongSequenceErrMsg), rejected: true}
+		}
+		if _, ok := chain.b.sequences[testWallet]; ok {
+			t.Fatal("the sequence should be queried again after a sequence mismatch")
+		}
+		return chain.sendTx(msgs)
+	}
+
+	chain.b.broadcast(msgs)
+	if len(chain.b.inFlight) != 1 {
+		t.Fatal("the tx should be sent again with the sequence from the chain")
+	}
+
+	// The sequence is still wrong the second time, the msgs aren't rejected but broadcast again later
+	wrongSequences = 2
+	chain.b.sequences[testWallet] = &accountSequence{accountNum: 1, sequence: 10}
+	chain.b.broadcast(msgs)
+	if requeued := chain.b.checkConfirmations(); len(requeued) != 2 {
+		t.Fatalf("expected the 2 msgs to be broadcast again, got %v", len(requeued))
+	}
+}
+
+func TestTxBroadcasterRequeue(t *testing.T) {
+	chain := newStubChain(t)
+	msgs := newTestMsgs(3)
+	maxRequeues := setting.Config.StratosChain.Broadcast.MaxRequeues
+
+	// The tx was dropped from the mempool
+	chain.b.queryTx = func(txHash string) (*abciv1beta1.TxResponse, error) {
+		return nil, grpcstatus.Error(grpccodes.NotFound, "tx not found")
+	}
+	chain.b.broadcast(msgs)
+	for i := 0; i <= maxRequeues; i++ {
+		chain.b.sequences[testWallet] = &accountSequence{accountNum: 1, sequence: 10}
+		for _, inFlight := range chain.b.inFlight {
+			inFlight.sentAt = time.Now().Add(-time.Hour)
+		}
+		requeued := chain.b.checkConfirmations()
+		if _, ok := chain.b.sequences[testWallet]; ok {
+			t.Fatal("the sequence should be queried again after a tx was dropped")
+		}
+		if i == maxRequeues {
+			if len(requeued) != 0 {
+				t.Fatalf("expected the msgs to be dropped after %v requeues", maxRequeues)
+			}
+			break
+		}
+		if len(requeued) != 3 {
+			t.Fatalf("expected the 3 msgs to be requeued, got %v", len(requeued))
+		}
+		chain.b.broadcast(requeued)
+	}
+	if len(chain.b.requeues) != 0 || len(chain.b.inFlight) != 0 {
+		t.Fatal("the dropped msgs should be forgotten")
+	}
+}
+
+func TestTxBroadcasterFailedInBlock(t *testing.T) {
+	chain := newStubChain(t)
+	msgs := newTestMsgs(4)
+	chain.failing[msgs[2]] = true
+	maxRequeues := setting.Config.StratosChain.Broadcast.MaxRequeues
+
+	chain.b.broadcast(msgs)
+	for round := 0; round < 10; round++ {
+		requeued := chain.b.checkConfirmations()
+		if len(requeued) == 0 {
+			break
+		}
+		chain.b.broadcast(requeued)
+	}
+
+	// Sent with 4 msgs, then 2, then alone until it reaches MaxRequeues
+	if count := chain.sentCount(msgs[2]); count != maxRequeues+3 {
+		t.Fatalf("expected the failing msg to be sent %v times, got %v", maxRequeues+3, count)
+	}
+	for i, msg := range msgs {
+		if i != 2 && chain.sentCount(msg) > 3 {
+			t.Fatalf("msg %v was sent again after being committed", i)
+		}
+	}
+	if len(chain.b.inFlight) != 0 || len(chain.b.requeues) != 0 || len(chain.b.failedInBlock) != 0 {
+		t.Fatal("the broadcaster should forget the msgs once committed or dropped")
+	}
+}
@@ -18,16 +18,19 @@ func main() {
 	startCmd := getStartCmd()
 	configCmd := getGenConfigCmd()
 	syncCmd := getSyncCmd()
+	outboxCmd := getOutboxCmd()
 	versionCmd := getVersionCmd()
 
 	rootCmd.AddCommand(startCmd)
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(syncCmd)
+	rootCmd.AddCommand(outboxCmd)
 	rootCmd.AddCommand(versionCmd)
 
 	err := rootCmd.Execute()
 	if err != nil {
 		utils.ErrorLog(err)
+		os.Exit(1)
 	}
 }
 
@@ -93,8 +96,10 @@ func rootPreRunE(cmd *cobra.Command, _ []string) error {
 
 func getSyncCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "sync",
-		Short:   "sync stchain tx to sp",
+		Use:   "sync [txHash]",
+		Short: "sync stchain tx to sp",
+		Long: "sync the events of a stchain tx to sp, or with --from-height the events of all the txs in a range of " +
+			"blocks",
 		RunE:    sync,
 		PreRunE: syncPreRunE,
 	}
@@ -105,6 +110,11 @@ func getSyncCmd() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringP(Home, "r", dir, "home path for the relayd process")
+	cmd.Flags().Int64(fromHeightFlag, 0, "first block to sync, instead of a single tx")
+	cmd.Flags().Int64(toHeightFlag, 0, "last block to sync (default the latest block)")
+	cmd.Flags().StringSlice(msgTypeFlag, nil, "only sync the events of these msg types (eg: MsgCreateResourceNode)")
+	cmd.Flags().Bool(dryRunFlag, false, "only print the events which would be synced")
+	cmd.Flags().Int64(batchBlocksFlag, 100, "blocks synced per request to relayd")
 	return cmd
 }
 
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stratosnet/sds/framework/utils"
+
+	"github.com/stratosnet/sds/relayer/cmd/relayd/setting"
+	"github.com/stratosnet/sds/relayer/outbox"
+	"github.com/stratosnet/sds/relayer/rpc"
+	"github.com/stratosnet/sds/relayer/server"
+)
+
+func getOutboxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbox",
+		Short: "inspect the notifications to sp which weren't delivered yet",
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		utils.ErrorLog("failed to get working directory")
+		panic(err)
+	}
+	cmd.PersistentFlags().StringP(Home, "r", dir, "home path for the relayd process")
+
+	listCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "list the pending notifications and the dead letters",
+		Args:    cobra.NoArgs,
+		RunE:    listOutbox,
+		PreRunE: syncPreRunE,
+	}
+	redriveCmd := &cobra.Command{
+		Use:     "redrive [key...]",
+		Short:   "deliver the notifications again now, all the dead letters when no key is given",
+		RunE:    outboxCall("outboxRedrive"),
+		PreRunE: syncPreRunE,
+	}
+	dropCmd := &cobra.Command{
+		Use:     "drop <key...>",
+		Short:   "remove notifications from the outbox without delivering them",
+		Args:    cobra.MinimumNArgs(1),
+		RunE:    outboxCall("outboxDrop"),
+		PreRunE: syncPreRunE,
+	}
+	cmd.AddCommand(listCmd)
+	cmd.AddCommand(redriveCmd)
+	cmd.AddCommand(dropCmd)
+	return cmd
+}
+
+func listOutbox(_ *cobra.Command, _ []string) error {
+	c, err := rpc.Dial(setting.IpcEndpoint)
+	if err != nil {
+		utils.ErrorLog(err)
+		return err
+	}
+	defer c.Close()
+
+	var result server.OutboxResult
+	if err = c.Call(&result, "relayer_outbox"); err != nil {
+		return err
+	}
+	fmt.Printf("%v pending notifications\n", len(result.Pending))
+	for _, entry := range result.Pending {
+		printOutboxEntry(entry)
+	}
+	fmt.Printf("%v dead letters\n", len(result.Dead))
+	for _, entry := range result.Dead {
+		printOutboxEntry(entry)
+	}
+	return nil
+}
+
+func printOutboxEntry(entry outbox.Entry) {
+	fmt.Printf("  %v %v created %v, %v attempts", entry.Key, entry.Endpoint, entry.Created.Format(time.RFC3339),
+		entry.Attempts)
+	if entry.Attempts > 0 {
+		fmt.Printf(", next at %v, last error: %v", entry.NextAttempt.Format(time.RFC3339), entry.LastError)
+	}
+	fmt.Println()
+}
+
+func outboxCall(method string) func(*cobra.Command, []string) error {
+	return func(_ *cobra.Command, args []string) error {
+		c, err := rpc.Dial(setting.IpcEndpoint)
+		if err != nil {
+			utils.ErrorLog(err)
+			return err
+		}
+		defer c.Close()
+
+		if args == nil {
+			args = []string{}
+		}
+		return callRpc(c, method, args)
+	}
+}
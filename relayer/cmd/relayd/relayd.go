@@ -50,6 +50,7 @@ func startRunE(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	server.BaseServer.SetLeaderCheck(multiClient.IsLeader)
 	err = server.BaseServer.Start()
 	defer server.BaseServer.Stop()
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/framework/utils/cache"
 )
 
 var Config *config
@@ -26,16 +27,30 @@ type grpcConfig struct {
 	Insecure   bool   `toml:"insecure"`
 }
 
+type outboxConfig struct {
+	MaxAttempts int `toml:"max_attempts"` // Attempts to deliver a notification before moving it to the dead letters
+	MinBackoff  int `toml:"min_backoff"`  // Milliseconds, doubled after each failed attempt
+	MaxBackoff  int `toml:"max_backoff"`  // Milliseconds
+	Timeout     int `toml:"timeout"`      // Milliseconds to wait for the SP node to answer a notification
+}
+
 type sds struct {
 	ApiPort           string            `toml:"api_port"`
 	NetworkAddress    string            `toml:"network_address"`
 	WebsocketPort     string            `toml:"websocket_port"`
 	ConnectionRetries connectionRetries `toml:"connection_retries"`
+	Outbox            outboxConfig      `toml:"outbox"`
 }
 
 type broadcast struct {
-	ChannelSize int `toml:"channel_size"`
-	MaxMsgPerTx int `toml:"max_msg_per_tx"`
+	ChannelSize         int `toml:"channel_size"`
+	MaxMsgPerTx         int `toml:"max_msg_per_tx"`
+	ConfirmationTimeout int `toml:"confirmation_timeout"` // Seconds before broadcasting again the msgs of a tx which isn't committed
+	MaxRequeues         int `toml:"max_requeues"`         // Times a msg is broadcast again before being dropped
+}
+
+type backfill struct {
+	BatchBlocks int64 `toml:"batch_blocks"` // Blocks scanned per query when catching up with the missed events
 }
 
 type stratoschain struct {
@@ -43,6 +58,7 @@ type stratoschain struct {
 	WebsocketServer   string            `toml:"websocket_server"`
 	ConnectionRetries connectionRetries `toml:"connection_retries"`
 	Broadcast         broadcast         `toml:"broadcast"`
+	Backfill          backfill          `toml:"backfill"`
 }
 
 type transactionsConfig struct {
@@ -70,12 +86,28 @@ type keysConfig struct {
 	WalletPassword string `toml:"wallet_password"`
 }
 
+const (
+	HaBackendFile  = "file"
+	HaBackendRedis = "redis"
+)
+
+type haConfig struct {
+	Enabled    bool         `toml:"enabled"`     // Only the instance holding the lease is active, the others are on standby
+	InstanceId string       `toml:"instance_id"` // Unique name of the instance, the hostname and pid when empty
+	Backend    string       `toml:"backend"`     // "file" or "redis"
+	LeaseDir   string       `toml:"lease_dir"`   // Folder shared by the instances, with the file backend
+	Redis      cache.Config `toml:"redis"`
+	KeyPrefix  string       `toml:"key_prefix"` // Prefix of the redis keys, with the redis backend
+	LeaseTtl   int          `toml:"lease_ttl"`  // Seconds before a standby instance takes over when the leader stops
+}
+
 type config struct {
 	BlockchainInfo blockchainInfoConfig `toml:"blockchain_info"`
 	Connectivity   connectivityConfig   `toml:"connectivity"`
 	Keys           keysConfig           `toml:"keys"`
 	SDS            sds                  `toml:"sds"`
 	StratosChain   stratoschain         `toml:"stratos_chain"`
+	HA             haConfig             `toml:"ha"`
 	Version        Version              `toml:"version"`
 }
 
@@ -119,6 +151,12 @@ func defaultConfig() *config {
 				SleepDuration:   3000,
 				RefreshInterval: 24 * 60 * 60,
 			},
+			Outbox: outboxConfig{
+				MaxAttempts: 30,
+				MinBackoff:  1000,
+				MaxBackoff:  10 * 60 * 1000,
+				Timeout:     10000,
+			},
 		},
 		StratosChain: stratoschain{
 			GrpcServer: grpcConfig{
@@ -132,9 +170,26 @@ func defaultConfig() *config {
 				RefreshInterval: 24 * 60 * 60,
 			},
 			Broadcast: broadcast{
-				ChannelSize: 2000,
-				MaxMsgPerTx: 250,
+				ChannelSize:         2000,
+				MaxMsgPerTx:         250,
+				ConfirmationTimeout: 60,
+				MaxRequeues:         3,
+			},
+			Backfill: backfill{
+				BatchBlocks: 1000,
+			},
+		},
+		HA: haConfig{
+			Enabled:  false,
+			Backend:  HaBackendFile,
+			LeaseDir: "/shared/relayd",
+			Redis: cache.Config{
+				Engine: "redis",
+				Host:   "127.0.0.1",
+				Port:   "6379",
 			},
+			KeyPrefix: "relayd",
+			LeaseTtl:  10,
 		},
 		Version: Version{AppVer: APP_VER, MinAppVer: MIN_APP_VER, Show: VERSION},
 	}
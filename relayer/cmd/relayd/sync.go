@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -13,7 +14,23 @@ import (
 	"github.com/stratosnet/sds/relayer/server"
 )
 
+const (
+	fromHeightFlag  = "from-height"
+	toHeightFlag    = "to-height"
+	msgTypeFlag     = "type"
+	dryRunFlag      = "dry-run"
+	batchBlocksFlag = "batch-blocks"
+)
+
 func sync(cmd *cobra.Command, args []string) error {
+	fromHeight, err := cmd.Flags().GetInt64(fromHeightFlag)
+	if err != nil {
+		return err
+	}
+	if fromHeight > 0 {
+		return syncRange(cmd, fromHeight)
+	}
+
 	if len(args) != 1 || len(args[0]) == 0 {
 		utils.ErrorLog("wrong number of arguments")
 		return nil
@@ -32,20 +49,86 @@ func sync(cmd *cobra.Command, args []string) error {
 	}
 	defer c.Close()
 
-	callRpc(c, "sync", args)
+	return callRpc(c, "sync", args)
+}
+
+// syncRange handles the events of the blocks from --from-height to --to-height, a batch of blocks at a time
+func syncRange(cmd *cobra.Command, fromHeight int64) error {
+	toHeight, err := cmd.Flags().GetInt64(toHeightFlag)
+	if err != nil {
+		return err
+	}
+	msgTypes, err := cmd.Flags().GetStringSlice(msgTypeFlag)
+	if err != nil {
+		return err
+	}
+	dryRun, err := cmd.Flags().GetBool(dryRunFlag)
+	if err != nil {
+		return err
+	}
+	batchBlocks, err := cmd.Flags().GetInt64(batchBlocksFlag)
+	if err != nil {
+		return err
+	}
+	if batchBlocks <= 0 {
+		return errors.New("--batch-blocks must be positive")
+	}
+
+	c, err := rpc.Dial(setting.IpcEndpoint)
+	if err != nil {
+		utils.ErrorLog(err)
+		return err
+	}
+	defer c.Close()
+
+	if toHeight <= 0 {
+		if err = c.Call(&toHeight, "relayer_latestHeight"); err != nil {
+			return errors.Wrap(err, "failed querying the latest block height")
+		}
+	}
+	if toHeight < fromHeight {
+		return errors.Errorf("--to-height %v is lower than --from-height %v", toHeight, fromHeight)
+	}
+
+	action := "handled"
+	if dryRun {
+		action = "would be handled"
+	}
+	fmt.Printf("syncing the blocks from %v to %v\n", fromHeight, toHeight)
+	txCount, eventCount := 0, 0
+	for start := fromHeight; start <= toHeight; start += batchBlocks {
+		end := start + batchBlocks - 1
+		if end > toHeight {
+			end = toHeight
+		}
+		var result server.SyncRangeResult
+		params := server.SyncRangeParams{FromHeight: start, ToHeight: end, MsgTypes: msgTypes, DryRun: dryRun}
+		if err = c.Call(&result, "relayer_syncRange", params); err != nil {
+			return errors.Wrapf(err, "failed syncing the blocks from %v to %v, run again with --from-height %v to resume",
+				start, end, start)
+		}
+		for _, tx := range result.Txs {
+			fmt.Printf("  height %v tx %v: %v %v\n", tx.Height, tx.Hash, strings.Join(tx.MsgTypes, ", "), action)
+			eventCount += len(tx.MsgTypes)
+		}
+		txCount += len(result.Txs)
+		fmt.Printf("[%v%%] blocks %v to %v synced, %v txs\n", (end-fromHeight+1)*100/(toHeight-fromHeight+1), start, end,
+			len(result.Txs))
+	}
+	fmt.Printf("done: %v events of %v txs %v\n", eventCount, txCount, action)
 	return nil
 }
 
-func callRpc(c *rpc.Client, line string, param []string) bool {
+// callRpc calls the relayer command on the running relayd and prints its result
+func callRpc(c *rpc.Client, line string, param []string) error {
 	var result server.CmdResult
 
 	err := c.Call(&result, "relayer_"+line, param)
 	if err != nil {
-		fmt.Println(err)
-		return false
+		return err
 	}
 	fmt.Println(result.Msg)
-	return true
+	return nil
 }
 
 func syncPreRunE(cmd *cobra.Command, _ []string) error {
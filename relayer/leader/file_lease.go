@@ -0,0 +1,117 @@
+package leader
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/relayer/utils/files"
+)
+
+const (
+	leaseFile  = "relayd.lease"
+	lockFile   = "relayd.lease.lock"
+	heightFile = "block_height"
+)
+
+// FileLease a lease in a folder shared by the instances, eg: on NFS. The lease file is only read and written while
+// holding an exclusive lock on the lock file. The clocks of the hosts must be synchronized
+type FileLease struct {
+	dir string
+}
+
+type fileLeaseContent struct {
+	Holder  string    `json:"holder"`
+	Expires time.Time `json:"expires"`
+}
+
+func NewFileLease(dir string) (*FileLease, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "failed creating the lease folder")
+	}
+	return &FileLease{dir: dir}, nil
+}
+
+func (l *FileLease) Acquire(holder string, ttl time.Duration) (held bool, err error) {
+	err = l.locked(func() error {
+		content, err := l.read()
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if content.Holder != holder && content.Holder != "" && now.Before(content.Expires) {
+			return nil
+		}
+		held = true
+		return l.write(&fileLeaseContent{Holder: holder, Expires: now.Add(ttl)})
+	})
+	return held, err
+}
+
+func (l *FileLease) Release(holder string) error {
+	return l.locked(func() error {
+		content, err := l.read()
+		if err != nil || content.Holder != holder {
+			return err
+		}
+		return l.write(&fileLeaseContent{})
+	})
+}
+
+func (l *FileLease) Height() (int64, error) {
+	content, err := os.ReadFile(filepath.Join(l.dir, heightFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed reading the shared block height")
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+}
+
+func (l *FileLease) SetHeight(height int64) error {
+	return files.WriteAtomic(filepath.Join(l.dir, heightFile), []byte(strconv.FormatInt(height, 10)))
+}
+
+func (l *FileLease) locked(fn func() error) error {
+	file, err := os.OpenFile(filepath.Join(l.dir, lockFile), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed opening the lease lock file")
+	}
+	defer file.Close()
+	if err = lockFileExclusive(file); err != nil {
+		return errors.Wrap(err, "failed locking the lease lock file")
+	}
+	defer unlockFile(file)
+	return fn()
+}
+
+func (l *FileLease) read() (*fileLeaseContent, error) {
+	content := &fileLeaseContent{}
+	raw, err := os.ReadFile(filepath.Join(l.dir, leaseFile))
+	if os.IsNotExist(err) {
+		return content, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading the lease file")
+	}
+	if len(raw) > 0 {
+		if err = json.Unmarshal(raw, content); err != nil {
+			return nil, errors.Wrap(err, "invalid lease file")
+		}
+	}
+	return content, nil
+}
+
+func (l *FileLease) write(content *fileLeaseContent) error {
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+	return files.WriteAtomic(filepath.Join(l.dir, leaseFile), raw)
+}
@@ -0,0 +1,128 @@
+package leader
+
+import (
+	"sync"
+	"time"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+// Lease a lease shared by the relayd instances. Only the instance holding it is active, the others are on standby,
+// ready to take over when it expires
+type Lease interface {
+	// Acquire takes the lease for the ttl when it's free or expired, or extends it when it's already held by the holder.
+	// Returns whether the holder holds the lease
+	Acquire(holder string, ttl time.Duration) (bool, error)
+	// Release frees the lease when it's held by the holder, so another instance can take over right away
+	Release(holder string) error
+	// Height the block height cursor of the last leader, so the next one resumes from it
+	Height() (int64, error)
+	SetHeight(height int64) error
+}
+
+// Elector keeps trying to acquire the lease, and calls onElected when the instance becomes the leader, and onDemoted
+// when it isn't anymore. The callbacks are called from the elector loop, one at a time
+type Elector struct {
+	lease      Lease
+	holder     string
+	ttl        time.Duration
+	onElected  func()
+	onDemoted  func()
+	isLeader   bool
+	lastRenew  time.Time
+	stop       chan struct{}
+	stopped    chan struct{}
+	stopOnce   sync.Once
+	leaderLock sync.RWMutex
+}
+
+func NewElector(lease Lease, holder string, ttl time.Duration, onElected, onDemoted func()) *Elector {
+	return &Elector{
+		lease:     lease,
+		holder:    holder,
+		ttl:       ttl,
+		onElected: onElected,
+		onDemoted: onDemoted,
+		stop:      make(chan struct{}),
+		stopped:   make(chan struct{}),
+	}
+}
+
+// Start the elector loop. The lease is renewed 3 times per ttl, so a standby instance takes over at most a ttl after
+// the leader stopped renewing it
+func (e *Elector) Start() {
+	go e.loop()
+}
+
+// Stop demotes the instance, and releases the lease so a standby instance can take over
+func (e *Elector) Stop() {
+	e.stopOnce.Do(func() {
+		close(e.stop)
+		<-e.stopped
+	})
+}
+
+// IsLeader whether the instance is currently the leader
+func (e *Elector) IsLeader() bool {
+	e.leaderLock.RLock()
+	defer e.leaderLock.RUnlock()
+	return e.isLeader
+}
+
+func (e *Elector) loop() {
+	defer close(e.stopped)
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		e.tryAcquire()
+		select {
+		case <-e.stop:
+			if e.IsLeader() {
+				e.setLeader(false)
+				if err := e.lease.Release(e.holder); err != nil {
+					utils.ErrorLog("Failed releasing the relayd lease:", err.Error())
+				}
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Elector) tryAcquire() {
+	held, err := e.lease.Acquire(e.holder, e.ttl)
+	if err != nil {
+		utils.ErrorLog("Failed acquiring the relayd lease:", err.Error())
+		// Another instance can take the lease once it expires, stop before it does
+		if e.IsLeader() && time.Since(e.lastRenew) > e.ttl*2/3 {
+			utils.ErrorLog("The relayd lease couldn't be renewed, switching to standby")
+			e.setLeader(false)
+		}
+		return
+	}
+
+	if held {
+		e.lastRenew = time.Now()
+	}
+	if held != e.IsLeader() {
+		if held {
+			utils.Logf("This relayd instance [%v] is now the leader", e.holder)
+		} else {
+			utils.Logf("This relayd instance [%v] lost the lease, switching to standby", e.holder)
+		}
+		e.setLeader(held)
+	}
+}
+
+func (e *Elector) setLeader(isLeader bool) {
+	e.leaderLock.Lock()
+	e.isLeader = isLeader
+	e.leaderLock.Unlock()
+
+	if isLeader {
+		e.onElected()
+	} else {
+		e.onDemoted()
+	}
+}
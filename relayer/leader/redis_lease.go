@@ -0,0 +1,72 @@
+package leader
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils/cache"
+)
+
+// acquireScript takes the lease when it's free, or extends it when it's held by the holder, in a single step
+var acquireScript = redis.NewScript(`
+local holder = redis.call("GET", KEYS[1])
+if holder == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+if not holder then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+return 0
+`)
+
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// RedisLease a lease held in a redis key, which expires with the lease
+type RedisLease struct {
+	client    *redis.Client
+	leaseKey  string
+	heightKey string
+}
+
+func NewRedisLease(config cache.Config, keyPrefix string) *RedisLease {
+	return &RedisLease{
+		client:    cache.NewRedis(config).Client,
+		leaseKey:  keyPrefix + ":lease",
+		heightKey: keyPrefix + ":block_height",
+	}
+}
+
+func (l *RedisLease) Acquire(holder string, ttl time.Duration) (bool, error) {
+	held, err := acquireScript.Run(l.client, []string{l.leaseKey}, holder, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, errors.Wrap(err, "failed acquiring the lease in redis")
+	}
+	return held == 1, nil
+}
+
+func (l *RedisLease) Release(holder string) error {
+	return errors.Wrap(releaseScript.Run(l.client, []string{l.leaseKey}, holder).Err(), "failed releasing the lease in redis")
+}
+
+func (l *RedisLease) Height() (int64, error) {
+	height, err := l.client.Get(l.heightKey).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return height, errors.Wrap(err, "failed reading the shared block height in redis")
+}
+
+func (l *RedisLease) SetHeight(height int64) error {
+	return errors.Wrap(l.client.Set(l.heightKey, strconv.FormatInt(height, 10), 0).Err(),
+		"failed writing the shared block height in redis")
+}
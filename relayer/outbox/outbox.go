@@ -0,0 +1,399 @@
+package outbox
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+
+	"github.com/stratosnet/sds/relayer/utils/files"
+)
+
+const (
+	pendingFolder = "pending"
+	deadFolder    = "dead"
+	fileExtension = ".json"
+
+	defaultMaxAttempts = 30
+	defaultMinBackoff  = time.Second
+	defaultMaxBackoff  = 10 * time.Minute
+)
+
+// Entry a notification to deliver to the SP. Each entry is a file of the outbox folder, so the notifications which
+// couldn't be delivered yet survive a restart of relayd
+type Entry struct {
+	Key         string          `json:"key"` // idempotency key, derived from the events notified
+	Endpoint    string          `json:"endpoint"`
+	Body        json.RawMessage `json:"body"`
+	Created     time.Time       `json:"created"`
+	Attempts    int             `json:"attempts"`
+	NextAttempt time.Time       `json:"next_attempt"`
+	LastError   string          `json:"last_error,omitempty"`
+}
+
+// DeliverFunc delivers the entry to the SP. The entry is delivered again later when an error is returned
+type DeliverFunc func(entry *Entry) error
+
+type Config struct {
+	MaxAttempts int           // attempts before an entry is moved to the dead letters
+	MinBackoff  time.Duration // delay before the 2nd attempt, doubled after each attempt
+	MaxBackoff  time.Duration
+}
+
+type outbox struct {
+	dir     string
+	config  Config
+	deliver DeliverFunc
+	mtx     sync.Mutex
+	pending map[string]*Entry
+	dead    map[string]*Entry
+	wake    chan struct{}
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+var (
+	current    *outbox
+	currentMtx sync.Mutex
+)
+
+// Start loads the entries of the outbox folder, and delivers the pending ones until Stop is called
+func Start(dir string, config Config, deliver DeliverFunc) error {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = defaultMinBackoff
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = defaultMaxBackoff
+	}
+
+	o := &outbox{
+		dir:     dir,
+		config:  config,
+		deliver: deliver,
+		wake:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	var err error
+	if o.pending, err = loadEntries(filepath.Join(dir, pendingFolder)); err != nil {
+		return err
+	}
+	if o.dead, err = loadEntries(filepath.Join(dir, deadFolder)); err != nil {
+		return err
+	}
+	if len(o.pending) > 0 || len(o.dead) > 0 {
+		utils.Logf("outbox: %v notifications to deliver to SP, %v dead letters", len(o.pending), len(o.dead))
+	}
+
+	currentMtx.Lock()
+	defer currentMtx.Unlock()
+	if current != nil {
+		return errors.New("the outbox is already started")
+	}
+	current = o
+	go o.deliverLoop()
+	return nil
+}
+
+// Stop stops delivering the entries. The pending entries are delivered when the outbox is started again
+func Stop() {
+	currentMtx.Lock()
+	o := current
+	current = nil
+	currentMtx.Unlock()
+	if o == nil {
+		return
+	}
+	close(o.stop)
+	<-o.stopped
+}
+
+func get() (*outbox, error) {
+	currentMtx.Lock()
+	defer currentMtx.Unlock()
+	if current == nil {
+		return nil, errors.New("the outbox isn't started")
+	}
+	return current, nil
+}
+
+// Add persists the notification, then delivers it at least once. A notification whose key is already in the outbox
+// is ignored
+func Add(key, endpoint string, body []byte) error {
+	o, err := get()
+	if err != nil {
+		return err
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if _, ok := o.pending[key]; ok {
+		return nil
+	}
+	if _, ok := o.dead[key]; ok {
+		return nil
+	}
+	now := time.Now()
+	entry := &Entry{Key: key, Endpoint: endpoint, Body: body, Created: now, NextAttempt: now}
+	if err = o.save(pendingFolder, entry); err != nil {
+		return err
+	}
+	o.pending[key] = entry
+	o.notify()
+	return nil
+}
+
+// List the entries waiting to be delivered, and the dead letters, oldest first
+func List() (pending, dead []Entry, err error) {
+	o, err := get()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	return sortedEntries(o.pending), sortedEntries(o.dead), nil
+}
+
+// Redrive delivers the entries again now, with their attempts reset. The dead letters go back to the pending
+// entries. Without keys, all the dead letters are re-driven. Returns the number of entries re-driven
+func Redrive(keys []string) (int, error) {
+	o, err := get()
+	if err != nil {
+		return 0, err
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if len(keys) == 0 {
+		for key := range o.dead {
+			keys = append(keys, key)
+		}
+	}
+	count := 0
+	for _, key := range keys {
+		entry, ok := o.pending[key]
+		if !ok {
+			if entry, ok = o.dead[key]; !ok {
+				return count, errors.Errorf("no entry [%v] in the outbox", key)
+			}
+		}
+		entry.Attempts = 0
+		entry.NextAttempt = time.Now()
+		if err = o.save(pendingFolder, entry); err != nil {
+			return count, err
+		}
+		if _, ok = o.dead[key]; ok {
+			o.remove(deadFolder, key)
+			delete(o.dead, key)
+		}
+		o.pending[key] = entry
+		count++
+	}
+	o.notify()
+	return count, nil
+}
+
+// Drop removes the entries from the outbox, they won't be delivered. Returns the number of entries dropped
+func Drop(keys []string) (int, error) {
+	o, err := get()
+	if err != nil {
+		return 0, err
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	count := 0
+	for _, key := range keys {
+		if _, ok := o.pending[key]; ok {
+			o.remove(pendingFolder, key)
+			delete(o.pending, key)
+		} else if _, ok = o.dead[key]; ok {
+			o.remove(deadFolder, key)
+			delete(o.dead, key)
+		} else {
+			return count, errors.Errorf("no entry [%v] in the outbox", key)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func (o *outbox) notify() {
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (o *outbox) deliverLoop() {
+	defer close(o.stopped)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-o.stop:
+			return
+		case <-o.wake:
+		case <-timer.C:
+		}
+
+		next := o.deliverDue()
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(time.Until(next))
+	}
+}
+
+// deliverDue delivers the entries whose next attempt is due, in the order they were added. Returns when the next
+// attempt is due
+func (o *outbox) deliverDue() time.Time {
+	now := time.Now()
+	next := now.Add(time.Minute)
+
+	o.mtx.Lock()
+	var due []*Entry
+	for _, entry := range o.pending {
+		if !entry.NextAttempt.After(now) {
+			entry := *entry
+			due = append(due, &entry)
+		}
+	}
+	o.mtx.Unlock()
+	sort.Slice(due, func(i, j int) bool { return due[i].Created.Before(due[j].Created) })
+
+	for _, entry := range due {
+		select {
+		case <-o.stop:
+			return next
+		default:
+		}
+		err := o.deliver(entry)
+
+		o.mtx.Lock()
+		if _, ok := o.pending[entry.Key]; !ok {
+			// dropped while it was delivered
+			o.mtx.Unlock()
+			continue
+		}
+		if err == nil {
+			o.remove(pendingFolder, entry.Key)
+			delete(o.pending, entry.Key)
+			o.mtx.Unlock()
+			continue
+		}
+
+		entry.Attempts++
+		entry.LastError = err.Error()
+		if entry.Attempts >= o.config.MaxAttempts {
+			utils.ErrorLogf("outbox: giving up delivering [%v] to SP %v after %v attempts, moved to the dead letters: %v",
+				entry.Key, entry.Endpoint, entry.Attempts, err.Error())
+			if saveErr := o.save(deadFolder, entry); saveErr != nil {
+				utils.ErrorLog("outbox:", saveErr.Error())
+			} else {
+				o.remove(pendingFolder, entry.Key)
+				delete(o.pending, entry.Key)
+				o.dead[entry.Key] = entry
+			}
+		} else {
+			entry.NextAttempt = time.Now().Add(o.backoff(entry.Attempts))
+			utils.ErrorLogf("outbox: failed delivering [%v] to SP %v (attempt %v), retrying at %v: %v", entry.Key,
+				entry.Endpoint, entry.Attempts, entry.NextAttempt.Format(time.RFC3339), err.Error())
+			if saveErr := o.save(pendingFolder, entry); saveErr != nil {
+				utils.ErrorLog("outbox:", saveErr.Error())
+			}
+			o.pending[entry.Key] = entry
+		}
+		o.mtx.Unlock()
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	for _, entry := range o.pending {
+		if entry.NextAttempt.Before(next) {
+			next = entry.NextAttempt
+		}
+	}
+	return next
+}
+
+func (o *outbox) backoff(attempts int) time.Duration {
+	backoff := o.config.MinBackoff
+	for i := 1; i < attempts && backoff < o.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > o.config.MaxBackoff {
+		backoff = o.config.MaxBackoff
+	}
+	return backoff
+}
+
+// save writes the entry in the folder, replacing the previous version of the entry
+func (o *outbox) save(folder string, entry *Entry) error {
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "failed encoding the outbox entry")
+	}
+	dir := filepath.Join(o.dir, folder)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrap(err, "failed creating the outbox folder")
+	}
+	return errors.Wrap(files.WriteAtomic(filepath.Join(dir, entry.Key+fileExtension), content),
+		"failed writing the outbox entry")
+}
+
+func (o *outbox) remove(folder, key string) {
+	if err := os.Remove(filepath.Join(o.dir, folder, key+fileExtension)); err != nil && !os.IsNotExist(err) {
+		utils.ErrorLog("outbox: failed removing an entry:", err.Error())
+	}
+}
+
+func loadEntries(dir string) (map[string]*Entry, error) {
+	entries := make(map[string]*Entry)
+	files, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed reading the outbox folder")
+	}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), fileExtension) {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed reading an outbox entry")
+		}
+		entry := &Entry{}
+		if err = json.Unmarshal(content, entry); err != nil {
+			utils.ErrorLogf("outbox: ignoring the invalid entry %v: %v", file.Name(), err.Error())
+			continue
+		}
+		entries[entry.Key] = entry
+	}
+	return entries, nil
+}
+
+func sortedEntries(entries map[string]*Entry) []Entry {
+	sorted := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		sorted = append(sorted, *entry)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Created.Before(sorted[j].Created) })
+	return sorted
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
+
 	"github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/tx-client/grpc"
 
+	"github.com/stratosnet/sds/relayer/cmd/relayd/setting"
+	"github.com/stratosnet/sds/relayer/outbox"
+	"github.com/stratosnet/sds/relayer/stratoschain"
 	"github.com/stratosnet/sds/relayer/stratoschain/handlers"
 )
 
@@ -18,11 +23,44 @@ type CmdResult struct {
 	Msg string
 }
 
+// SyncRangeParams the blocks scanned by SyncRange, and the msg types of the events to handle (all when empty)
+type SyncRangeParams struct {
+	FromHeight int64
+	ToHeight   int64
+	MsgTypes   []string
+	DryRun     bool
+}
+
+type SyncedTx struct {
+	Hash     string
+	Height   int64
+	MsgTypes []string
+}
+
+type SyncRangeResult struct {
+	Txs []SyncedTx
+}
+
+// OutboxResult the notifications waiting to be delivered to the SP, and the ones which couldn't be delivered
+type OutboxResult struct {
+	Pending []outbox.Entry
+	Dead    []outbox.Entry
+}
+
 type relayCmd struct {
+	isLeader func() bool
 }
 
-func RelayAPI() *relayCmd {
-	return &relayCmd{}
+func RelayAPI(isLeader func() bool) *relayCmd {
+	return &relayCmd{isLeader: isLeader}
+}
+
+// checkLeader refuses to handle events on an instance on standby, the leader would handle them a second time
+func (api *relayCmd) checkLeader() error {
+	if api.isLeader != nil && !api.isLeader() {
+		return errors.New("this relayd instance is on standby, sync against the leader")
+	}
+	return nil
 }
 
 func (api *relayCmd) Sync(ctx context.Context, param []string) (CmdResult, error) {
@@ -30,6 +68,9 @@ func (api *relayCmd) Sync(ctx context.Context, param []string) (CmdResult, error
 		utils.ErrorLog("wrong number of arguments")
 		return CmdResult{Msg: ""}, fmt.Errorf("wrong number of arguments")
 	}
+	if err := api.checkLeader(); err != nil {
+		return CmdResult{Msg: ""}, err
+	}
 	txHash := param[0]
 	txResponse, err := grpc.QueryTxByHash(txHash)
 	if err != nil {
@@ -51,3 +92,86 @@ func (api *relayCmd) Sync(ctx context.Context, param []string) (CmdResult, error
 
 	return CmdResult{Msg: DefaultMsg}, nil
 }
+
+// LatestHeight the height of the latest block of stratos-chain
+func (api *relayCmd) LatestHeight(ctx context.Context) (int64, error) {
+	client, err := stratoschain.NewRpcClient(setting.Config.StratosChain.WebsocketServer)
+	if err != nil {
+		return 0, err
+	}
+	return stratoschain.LatestHeight(ctx, client)
+}
+
+// SyncRange passes the events of the txs committed in the blocks from FromHeight to ToHeight (both included) to their
+// handlers, in the order of the blocks. Meant to be called with a few blocks at a time, so the caller can follow the
+// progress. The events which were already handled recently are ignored by the handlers
+func (api *relayCmd) SyncRange(ctx context.Context, params SyncRangeParams) (SyncRangeResult, error) {
+	if params.FromHeight <= 0 || params.ToHeight < params.FromHeight {
+		return SyncRangeResult{}, errors.Errorf("invalid block range from %v to %v", params.FromHeight, params.ToHeight)
+	}
+	if !params.DryRun {
+		// a dry run only lists the txs, it can run on standby
+		if err := api.checkLeader(); err != nil {
+			return SyncRangeResult{}, err
+		}
+	}
+	var msgTypes []string
+	for msgType := range handlers.Handlers {
+		if handlers.MatchMsgType(msgType, params.MsgTypes) {
+			msgTypes = append(msgTypes, msgType)
+		}
+	}
+	if len(msgTypes) == 0 {
+		return SyncRangeResult{}, errors.Errorf("no handler for the msg types %v", params.MsgTypes)
+	}
+
+	client, err := stratoschain.NewRpcClient(setting.Config.StratosChain.WebsocketServer)
+	if err != nil {
+		return SyncRangeResult{}, err
+	}
+	txs, err := stratoschain.SearchTxs(ctx, client, msgTypes, params.FromHeight, params.ToHeight)
+	if err != nil {
+		return SyncRangeResult{}, err
+	}
+
+	result := SyncRangeResult{}
+	for _, tx := range txs {
+		txHash := tx.Hash.String()
+		handled, err := handlers.HandleTx(txHash, params.MsgTypes, params.DryRun)
+		if err != nil {
+			return result, errors.Wrapf(err, "failed handling tx [%v]", txHash)
+		}
+		result.Txs = append(result.Txs, SyncedTx{Hash: txHash, Height: tx.Height, MsgTypes: handled})
+	}
+	return result, nil
+}
+
+// Outbox lists the entries of the outbox
+func (api *relayCmd) Outbox(ctx context.Context) (OutboxResult, error) {
+	pending, dead, err := outbox.List()
+	if err != nil {
+		return OutboxResult{}, err
+	}
+	return OutboxResult{Pending: pending, Dead: dead}, nil
+}
+
+// OutboxRedrive delivers the entries of the outbox again now, all the dead letters when no key is given
+func (api *relayCmd) OutboxRedrive(ctx context.Context, keys []string) (CmdResult, error) {
+	count, err := outbox.Redrive(keys)
+	if err != nil {
+		return CmdResult{}, err
+	}
+	return CmdResult{Msg: fmt.Sprintf("%v notifications re-driven", count)}, nil
+}
+
+// OutboxDrop removes entries from the outbox without delivering them
+func (api *relayCmd) OutboxDrop(ctx context.Context, keys []string) (CmdResult, error) {
+	if len(keys) == 0 {
+		return CmdResult{}, errors.New("no key given")
+	}
+	count, err := outbox.Drop(keys)
+	if err != nil {
+		return CmdResult{}, err
+	}
+	return CmdResult{Msg: fmt.Sprintf("%v notifications dropped", count)}, nil
+}
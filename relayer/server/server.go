@@ -24,6 +24,12 @@ var BaseServer = &BaseRelayServer{}
 type BaseRelayServer struct {
 	ipcServ     *namespace.IpcServer
 	httpRpcServ *namespace.HttpServer
+	isLeader    func() bool
+}
+
+// SetLeaderCheck tells the server whether the instance is the leader, only the leader handles the synced events
+func (bs *BaseRelayServer) SetLeaderCheck(isLeader func() bool) {
+	bs.isLeader = isLeader
 }
 
 func (bs *BaseRelayServer) Start() error {
@@ -41,7 +47,7 @@ func (bs *BaseRelayServer) startIPC() error {
 		{
 			Namespace: "relayer",
 			Version:   "1.0",
-			Service:   RelayAPI(),
+			Service:   RelayAPI(bs.isLeader),
 			Public:    false,
 		},
 	}
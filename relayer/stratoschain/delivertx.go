@@ -1,6 +1,9 @@
 package stratoschain
 
 import (
+	"context"
+
+	abciv1beta1 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
 
 	"github.com/stratosnet/sds/framework/utils"
@@ -10,19 +13,20 @@ import (
 	"github.com/stratosnet/sds/relayer/stratoschain/handlers"
 )
 
-func BroadcastTx(txBytes []byte) error {
+// BroadcastTx broadcasts the tx once it's checked by the mempool. The response code tells whether the tx was accepted
+func BroadcastTx(txBytes []byte) (*abciv1beta1.TxResponse, error) {
 
 	resp, err := grpc.BroadcastTx(txBytes, txv1beta1.BroadcastMode_BROADCAST_MODE_SYNC)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if setting.Config == nil {
-		return nil // If the relayd config is nil, then this is ppd broadcasting a tx. We don't want to call the event handler in this case
+		return resp.TxResponse, nil // If the relayd config is nil, then this is ppd broadcasting a tx. We don't want to call the event handler in this case
 	}
 
 	if len(resp.TxResponse.Logs) == 0 {
-		return nil
+		return resp.TxResponse, nil
 	}
 
 	events := handlers.ExtractEventsFromTxResponse(resp.TxResponse)
@@ -34,5 +38,21 @@ func BroadcastTx(txBytes []byte) error {
 			utils.ErrorLogf("No handler for event type [%v]", msgType)
 		}
 	}
-	return nil
+	return resp.TxResponse, nil
+}
+
+// QueryTx fetches a tx committed in a block, whether it succeeded or not. The gRPC error is returned as is, so a
+// NotFound status tells that the tx isn't in any block
+func QueryTx(txHash string) (*abciv1beta1.TxResponse, error) {
+	conn, err := grpc.CreateGrpcConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	resp, err := txv1beta1.NewServiceClient(conn).GetTx(context.Background(), &txv1beta1.GetTxRequest{Hash: txHash})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTxResponse(), nil
 }
@@ -21,11 +21,19 @@ import (
 	fwcryptotypes "github.com/stratosnet/sds/framework/crypto/types"
 	"github.com/stratosnet/sds/framework/utils"
 	"github.com/stratosnet/sds/relayer/cmd/relayd/setting"
+	"github.com/stratosnet/sds/relayer/outbox"
 	"github.com/stratosnet/sds/relayer/stratoschain/types"
 	"github.com/stratosnet/sds/sds-msg/protos"
 	"github.com/stratosnet/sds/sds-msg/relay"
 )
 
+const (
+	// IdempotencyKeyHeader identifies the events of a notification delivered to the SP, the same in each attempt
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	defaultDeliveryTimeout = 10 * time.Second
+)
+
 var Handlers map[string]func(coretypes.ResultEvent)
 var cache *utils.AutoCleanMap // Cache with a TTL to make sure each event is only handled once
 
@@ -49,7 +57,8 @@ func init() {
 	Handlers[types.MSG_TYPE_UPDATE_EFFECTIVE_DEPOSIT] = UpdateEffectiveDepositHandler()
 	Handlers[types.MSG_TYPE_EVM_TX] = EvmTxHandler()
 
-	cache = utils.NewAutoCleanMap(time.Minute)
+	// the events are kept long enough to also ignore the ones scanned again by the backfill after a reconnection
+	cache = utils.NewAutoCleanMap(10 * time.Minute)
 }
 
 func ExtractEventsFromTxResponse(response *abciv1beta1.TxResponse) []coretypes.ResultEvent {
@@ -169,7 +178,7 @@ func CreateResourceNodeMsgHandler() func(event coretypes.ResultEvent) {
 			return
 		}
 
-		err := postToSP("/pp/activated", req)
+		err := postToSP(key, "/pp/activated", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -215,7 +224,7 @@ func UpdateResourceNodeMsgHandler() func(event coretypes.ResultEvent) {
 			return
 		}
 
-		err := postToSP("/pp/updateBeneficiaryAddress", req)
+		err := postToSP(key, "/pp/updateBeneficiaryAddress", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -269,7 +278,7 @@ func UpdateResourceNodeDepositMsgHandler() func(event coretypes.ResultEvent) {
 			return
 		}
 
-		err := postToSP("/pp/updatedDeposit", req)
+		err := postToSP(key, "/pp/updatedDeposit", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -317,7 +326,7 @@ func UnbondingResourceNodeMsgHandler() func(event coretypes.ResultEvent) {
 			return
 		}
 
-		err := postToSP("/pp/unbonding", req)
+		err := postToSP(key, "/pp/unbonding", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -361,7 +370,7 @@ func CompleteUnbondingResourceNodeMsgHandler() func(event coretypes.ResultEvent)
 			return
 		}
 
-		err := postToSP("/pp/deactivated", req)
+		err := postToSP(key, "/pp/deactivated", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -422,7 +431,7 @@ func UpdateMetaNodeDepositMsgHandler() func(event coretypes.ResultEvent) {
 			return
 		}
 
-		err := postToSP("/chain/updatedDeposit", req)
+		err := postToSP(key, "/chain/updatedDeposit", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -470,7 +479,7 @@ func UnbondingMetaNodeMsgHandler() func(event coretypes.ResultEvent) {
 			return
 		}
 
-		err := postToSP("/chain/unbonding", req)
+		err := postToSP(key, "/chain/unbonding", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -530,7 +539,7 @@ func MetaNodeVoteMsgHandler() func(event coretypes.ResultEvent) {
 			return
 		}
 
-		err := postToSP("/chain/activated", req)
+		err := postToSP(key, "/chain/activated", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -597,7 +606,7 @@ func FileUploadMsgHandler() func(event coretypes.ResultEvent) {
 			return
 		}
 
-		err := postToSP("/pp/uploaded", req)
+		err := postToSP(key, "/pp/uploaded", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -638,7 +647,7 @@ func VolumeReportHandler() func(event coretypes.ResultEvent) {
 			return
 		}
 
-		err := postToSP("/volume/reported", req)
+		err := postToSP(key, "/volume/reported", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -700,7 +709,7 @@ func SlashingResourceNodeHandler() func(event coretypes.ResultEvent) {
 			PPList: slashedPPs,
 			TxHash: txHash,
 		}
-		err := postToSP("/pp/slashed", req)
+		err := postToSP(key, "/pp/slashed", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -771,7 +780,7 @@ func UpdateEffectiveDepositHandler() func(event coretypes.ResultEvent) {
 			PPList: updatedPPs,
 			TxHash: txHash,
 		}
-		err := postToSP("/pp/updatedEffectiveDeposit", req)
+		err := postToSP(key, "/pp/updatedEffectiveDeposit", req)
 		if err != nil {
 			utils.ErrorLog(err)
 			return
@@ -804,7 +813,7 @@ func processPrePayEvent(requiredAttributes []string, processedEvents []map[strin
 		return
 	}
 
-	err := postToSP("/pp/prepaid", req)
+	err := postToSP(key, "/pp/prepaid", req)
 	if err != nil {
 		utils.ErrorLog(err)
 		return
@@ -863,30 +872,51 @@ func processEvmTxEvents(events []abcitypes.Event) (
 	return nil, ""
 }
 
-func postToSP(endpoint string, data interface{}) error {
+// postToSP adds the notification to the outbox, which delivers it to the SP at least once. The key identifies the
+// events notified, so the SP can ignore a notification delivered twice
+func postToSP(key, endpoint string, data interface{}) error {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
 		return errors.New("Error when trying to marshal data to json: " + err.Error())
 	}
+	return outbox.Add(hex.EncodeToString([]byte(key)), endpoint, jsonData)
+}
 
+// DeliverToSP calls the endpoint of the SP node with an entry of the outbox
+func DeliverToSP(entry *outbox.Entry) error {
 	url := utils.Url{
 		Scheme: "http",
 		Host:   setting.Config.SDS.NetworkAddress,
 		Port:   setting.Config.SDS.ApiPort,
-		Path:   endpoint,
+		Path:   entry.Endpoint,
 	}
 
-	resp, err := http.Post(url.String(true, true, true, false), "application/json", bytes.NewBuffer(jsonData))
+	request, err := http.NewRequest(http.MethodPost, url.String(true, true, true, false), bytes.NewBuffer(entry.Body))
 	if err != nil {
-		return errors.New("Error when calling " + endpoint + " endpoint in SP node: " + err.Error())
+		return err
 	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(IdempotencyKeyHeader, entry.Key)
+	timeout := time.Duration(setting.Config.SDS.Outbox.Timeout) * time.Millisecond
+	if timeout <= 0 {
+		timeout = defaultDeliveryTimeout
+	}
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Do(request)
+	if err != nil {
+		return errors.New("Error when calling " + entry.Endpoint + " endpoint in SP node: " + err.Error())
+	}
+	defer resp.Body.Close()
 
 	var res map[string]interface{}
 	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
 		return err
 	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("%v endpoint in SP node responded %v: %v", entry.Endpoint, resp.StatusCode, res["Msg"])
+	}
 
-	utils.Log(endpoint+" endpoint response from SP node", resp.StatusCode, res["Msg"])
+	utils.Log(entry.Endpoint+" endpoint response from SP node", resp.StatusCode, res["Msg"])
 	return nil
 }
 
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/stratosnet/sds/framework/utils"
+	"github.com/stratosnet/sds/tx-client/grpc"
+)
+
+// MatchMsgType whether the msgType is selected by the filters, given as full type URLs
+// ("/stratos.register.v1.MsgCreateResourceNode") or message names ("MsgCreateResourceNode"). No filter selects all
+func MatchMsgType(msgType string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		if msgType == filter || strings.HasSuffix(msgType, "."+filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// HandleTx passes the events of the tx to their handlers, in the order of its messages, like when they are received
+// from the subscription. Only the events selected by the filters are handled, and none in dryRun. Returns the msg
+// types of the selected events
+func HandleTx(txHash string, filters []string, dryRun bool) ([]string, error) {
+	txResponse, err := grpc.QueryTxByHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgTypes []string
+	for _, event := range ExtractEventsFromTxResponse(txResponse) {
+		msgType := GetMsgType(event)
+		handler, ok := Handlers[msgType]
+		if !ok || handler == nil || !MatchMsgType(msgType, filters) {
+			continue
+		}
+		msgTypes = append(msgTypes, msgType)
+		if dryRun {
+			continue
+		}
+		utils.Logf("Handling a message of type [%v] from tx [%v]", msgType, txHash)
+		handler(event)
+	}
+	return msgTypes, nil
+}
@@ -0,0 +1,73 @@
+package stratoschain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/stratosnet/sds/framework/utils"
+)
+
+const txSearchPerPage = 100 // maximum accepted by the chain RPC
+
+// NewRpcClient a client of the RPC of stratos-chain, to query its blocks and txs. Unlike DialWebsocket, it doesn't
+// connect the websocket used by the subscriptions
+func NewRpcClient(addr string) (*http.HTTP, error) {
+	url, err := utils.ParseUrl(addr)
+	if err != nil {
+		return nil, err
+	}
+	client, err := http.New(url.String(true, true, false, false), "/websocket")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create stratos-chain RPC client")
+	}
+	return client, nil
+}
+
+// LatestHeight the height of the latest block committed by the chain
+func LatestHeight(ctx context.Context, client *http.HTTP) (int64, error) {
+	status, err := client.Status(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed querying the status of stratos-chain")
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// SearchTxs the txs containing a message of one of the msgTypes in the blocks from fromHeight to toHeight (both
+// included), ordered as they were committed. The chain must index the txs (tx_index.indexer = "kv")
+func SearchTxs(ctx context.Context, client *http.HTTP, msgTypes []string, fromHeight, toHeight int64) ([]*coretypes.ResultTx, error) {
+	found := make(map[string]*coretypes.ResultTx)
+	for _, msgType := range msgTypes {
+		query := fmt.Sprintf("message.action='%v' AND tx.height>=%v AND tx.height<=%v", msgType, fromHeight, toHeight)
+		for page := 1; ; page++ {
+			perPage := txSearchPerPage
+			result, err := client.TxSearch(ctx, query, false, &page, &perPage, "asc")
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed searching the txs of type [%v] from height %v to %v", msgType, fromHeight, toHeight)
+			}
+			// a tx with messages of several types is found once per type
+			for _, tx := range result.Txs {
+				found[tx.Hash.String()] = tx
+			}
+			if len(result.Txs) == 0 || page*perPage >= result.TotalCount {
+				break
+			}
+		}
+	}
+
+	txs := make([]*coretypes.ResultTx, 0, len(found))
+	for _, tx := range found {
+		txs = append(txs, tx)
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].Height != txs[j].Height {
+			return txs[i].Height < txs[j].Height
+		}
+		return txs[i].Index < txs[j].Index
+	})
+	return txs, nil
+}
@@ -0,0 +1,22 @@
+package files
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// WriteAtomic writes the content to a temp file first and renames it over the path, so a crash can't leave a
+// truncated file
+func WriteAtomic(path string, content []byte) error {
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0600); err != nil {
+		return errors.Wrap(err, "failed writing "+filepath.Base(path))
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return errors.Wrap(err, "failed writing "+filepath.Base(path))
+	}
+	return nil
+}
@@ -9,9 +9,16 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/stratosnet/sds/framework/utils"
 )
 
+// tracer the span of each method call, child of the trace context sent by the HTTP client if any
+var tracer = otel.Tracer("github.com/stratosnet/sds/rpc")
+
 // handler handles JSON-RPC messages. There is one handler per connection. Note that
 // handler is not safe for concurrent use. Message handling never blocks indefinitely
 // because RPCs are processed on background goroutines launched by handler.
@@ -350,8 +357,12 @@ func (h *handler) handleSubscribe(cp *callProc, msg *jsonrpcMessage) *jsonrpcMes
 
 // runMethod runs the Go callback for an RPC method.
 func (h *handler) runMethod(ctx context.Context, msg *jsonrpcMessage, callb *callback, args []reflect.Value) *jsonrpcMessage {
+	ctx, span := tracer.Start(ctx, "rpc "+msg.Method, trace.WithSpanKind(trace.SpanKindServer))
+	defer span.End()
 	result, err := callb.call(ctx, msg.Method, args)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return msg.errorResponse(err)
 	}
 	return msg.response(result)
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"strconv"
+	"strings"
 )
 
 // GetFileUploadWalletSignMessage upload: wallet sign message for file upload request from the (rpc or cmd) user
@@ -53,9 +54,30 @@ func RegisterNewPPWalletSignMessage(walletAddr string, timestamp int64) string {
 func ShareFileWalletSignMessage(fileHash, walletAddr string, timestamp int64) string {
 	return fileHash + walletAddr + strconv.FormatInt(timestamp, 10)
 }
+
+// ShareFileToRecipientsWalletSignMessage share: wallet sign message for sharing a file to specific wallets, in the order
+// they are given in the request
+func ShareFileToRecipientsWalletSignMessage(fileHash, walletAddr string, recipients []string, timestamp int64) string {
+	return fileHash + walletAddr + strings.Join(recipients, ",") + strconv.FormatInt(timestamp, 10)
+}
 func ShareLinkWalletSignMessage(walletAddr string, timestamp int64) string {
 	return walletAddr + strconv.FormatInt(timestamp, 10)
 }
 func ClearExpiredShareLinksWalletSignMessage(walletAddr string, timestamp int64) string {
 	return walletAddr + strconv.FormatInt(timestamp, 10)
 }
+
+// GetDirectoryUploadWalletSignMessage upload: wallet sign message for uploading a directory of the node from the rpc user
+func GetDirectoryUploadWalletSignMessage(dirPath, walletAddr string, timestamp int64) string {
+	return dirPath + walletAddr + strconv.FormatInt(timestamp, 10)
+}
+
+// GetDirectoryDownloadWalletSignMessage download: wallet sign message for restoring a directory on the node from the rpc user
+func GetDirectoryDownloadWalletSignMessage(manifestHandle, walletAddr string, timestamp int64) string {
+	return manifestHandle + walletAddr + strconv.FormatInt(timestamp, 10)
+}
+
+// GetObjectVersionWalletSignMessage version: wallet sign message for an action on a versioned object of the node from the rpc user
+func GetObjectVersionWalletSignMessage(action, key, walletAddr string, timestamp int64) string {
+	return action + key + walletAddr + strconv.FormatInt(timestamp, 10)
+}